@@ -0,0 +1,185 @@
+// Package admin serves operator-only HTTP endpoints. Bind it to a private
+// address; it has no authentication of its own.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"log"
+	"net/http"
+	"strconv"
+
+	"app/object/games"
+	"app/protocol"
+)
+
+// CardAdmin changes players' card collections.
+type CardAdmin interface {
+	Collection(ctx context.Context, name string) (protocol.Collection, error)
+	GrantCard(ctx context.Context, name string, id uint32) (bool, error)
+	RevokeCard(ctx context.Context, name string, id uint32) (bool, error)
+}
+
+type Server struct {
+	Catalog string    // -catalog 파일, reload 때 다시 읽는다
+	Cards   CardAdmin // nil 이면 /cards 를 쓰지 않는다
+
+	OnRevoke func(name string, id uint32) // 카드를 회수한 뒤 부른다, 대기실의 덱에서도 빼도록
+}
+
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/catalog", s.catalog)
+	mux.HandleFunc("/catalog/reload", s.reload)
+	mux.HandleFunc("/cards", s.collection)
+	mux.HandleFunc("/cards/grant", s.grant)
+	mux.HandleFunc("/cards/revoke", s.revoke)
+	return mux
+}
+
+func (s *Server) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, s.Handler())
+}
+
+type catalogInfo struct {
+	Version     string `json:"version"`
+	Cards       int    `json:"cards"`
+	Units       int    `json:"units"`
+	Projectiles int    `json:"projectiles"`
+}
+
+func info(c *games.Catalog) catalogInfo {
+	return catalogInfo{
+		Version:     c.Version,
+		Cards:       len(c.Cards),
+		Units:       len(c.Units),
+		Projectiles: len(c.Projectiles),
+	}
+}
+
+// GET /catalog
+func (s *Server) catalog(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, info(games.CurrentCatalog()))
+}
+
+// POST /catalog/reload, 대기 중인 방과 이후 시작하는 게임에 적용된다
+func (s *Server) reload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.Catalog == "" {
+		http.Error(w, "no catalog file configured, start the server with -catalog", http.StatusConflict)
+		return
+	}
+	c, err := games.ReloadCatalog(s.Catalog)
+	if err != nil {
+		log.Println("catalog reload failed :", err)
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	writeJSON(w, info(c))
+}
+
+// GET /cards?player=name
+func (s *Server) collection(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.Cards == nil {
+		http.Error(w, "card collections are disabled", http.StatusConflict)
+		return
+	}
+	name := r.URL.Query().Get("player")
+	if name == "" || len(name) > protocol.MaxNameLength {
+		http.Error(w, "player is required", http.StatusBadRequest)
+		return
+	}
+	col, err := s.Cards.Collection(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, struct {
+		Player     string   `json:"player"`
+		Experience uint32   `json:"experience"`
+		Cards      []uint32 `json:"cards"`
+	}{name, col.Experience, col.Cards})
+}
+
+// POST /cards/grant?player=name&card=id
+func (s *Server) grant(w http.ResponseWriter, r *http.Request) {
+	s.change(w, r, "grant")
+}
+
+// POST /cards/revoke?player=name&card=id
+func (s *Server) revoke(w http.ResponseWriter, r *http.Request) {
+	s.change(w, r, "revoke")
+}
+
+func (s *Server) change(w http.ResponseWriter, r *http.Request, op string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if s.Cards == nil {
+		http.Error(w, "card collections are disabled", http.StatusConflict)
+		return
+	}
+	name := r.URL.Query().Get("player")
+	if name == "" || len(name) > protocol.MaxNameLength {
+		http.Error(w, "player is required", http.StatusBadRequest)
+		return
+	}
+	id, err := strconv.ParseUint(r.URL.Query().Get("card"), 10, 32)
+	if err != nil || id == 0 {
+		http.Error(w, "card must be a positive card id", http.StatusBadRequest)
+		return
+	}
+	apply := s.Cards.GrantCard
+	if op == "revoke" {
+		apply = s.Cards.RevokeCard
+	}
+	changed, err := apply(r.Context(), name, uint32(id))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	log.Println("admin", op, "card", id, "for", name, ":", changed)
+	if op == "revoke" && changed && s.OnRevoke != nil {
+		s.OnRevoke(name, uint32(id))
+	}
+	writeJSON(w, struct {
+		Player  string `json:"player"`
+		Card    uint32 `json:"card"`
+		Changed bool   `json:"changed"`
+	}{name, uint32(id), changed})
+}
+
+// protocol.Error 는 요청 잘못, 나머지는 서버 쪽 문제
+func writeError(w http.ResponseWriter, err error) {
+	if e, ok := err.(protocol.Error); ok {
+		status := http.StatusBadRequest
+		if e.Code == protocol.ErrCodeNotFound {
+			status = http.StatusNotFound
+		}
+		http.Error(w, e.Message, status)
+		return
+	}
+	log.Println(err)
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println(err)
+	}
+}
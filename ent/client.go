@@ -9,8 +9,12 @@ import (
 
 	"app/ent/migrate"
 
+	"app/ent/deck"
 	"app/ent/game"
+	"app/ent/ownedcard"
+	"app/ent/participant"
 	"app/ent/player"
+	"app/ent/ratingchange"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -22,10 +26,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Deck is the client for interacting with the Deck builders.
+	Deck *DeckClient
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
+	// OwnedCard is the client for interacting with the OwnedCard builders.
+	OwnedCard *OwnedCardClient
+	// Participant is the client for interacting with the Participant builders.
+	Participant *ParticipantClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
+	// RatingChange is the client for interacting with the RatingChange builders.
+	RatingChange *RatingChangeClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -39,8 +51,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Deck = NewDeckClient(c.config)
 	c.Game = NewGameClient(c.config)
+	c.OwnedCard = NewOwnedCardClient(c.config)
+	c.Participant = NewParticipantClient(c.config)
 	c.Player = NewPlayerClient(c.config)
+	c.RatingChange = NewRatingChangeClient(c.config)
 }
 
 // Open opens a database/sql.DB specified by the driver name and
@@ -72,10 +88,14 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Game:   NewGameClient(cfg),
-		Player: NewPlayerClient(cfg),
+		ctx:          ctx,
+		config:       cfg,
+		Deck:         NewDeckClient(cfg),
+		Game:         NewGameClient(cfg),
+		OwnedCard:    NewOwnedCardClient(cfg),
+		Participant:  NewParticipantClient(cfg),
+		Player:       NewPlayerClient(cfg),
+		RatingChange: NewRatingChangeClient(cfg),
 	}, nil
 }
 
@@ -93,16 +113,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config: cfg,
-		Game:   NewGameClient(cfg),
-		Player: NewPlayerClient(cfg),
+		config:       cfg,
+		Deck:         NewDeckClient(cfg),
+		Game:         NewGameClient(cfg),
+		OwnedCard:    NewOwnedCardClient(cfg),
+		Participant:  NewParticipantClient(cfg),
+		Player:       NewPlayerClient(cfg),
+		RatingChange: NewRatingChangeClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Game.
+//		Deck.
 //		Query().
 //		Count(ctx)
 //
@@ -125,8 +149,116 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Deck.Use(hooks...)
 	c.Game.Use(hooks...)
+	c.OwnedCard.Use(hooks...)
+	c.Participant.Use(hooks...)
 	c.Player.Use(hooks...)
+	c.RatingChange.Use(hooks...)
+}
+
+// DeckClient is a client for the Deck schema.
+type DeckClient struct {
+	config
+}
+
+// NewDeckClient returns a client for the Deck from the given config.
+func NewDeckClient(c config) *DeckClient {
+	return &DeckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deck.Hooks(f(g(h())))`.
+func (c *DeckClient) Use(hooks ...Hook) {
+	c.hooks.Deck = append(c.hooks.Deck, hooks...)
+}
+
+// Create returns a create builder for Deck.
+func (c *DeckClient) Create() *DeckCreate {
+	mutation := newDeckMutation(c.config, OpCreate)
+	return &DeckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Deck entities.
+func (c *DeckClient) CreateBulk(builders ...*DeckCreate) *DeckCreateBulk {
+	return &DeckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Deck.
+func (c *DeckClient) Update() *DeckUpdate {
+	mutation := newDeckMutation(c.config, OpUpdate)
+	return &DeckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeckClient) UpdateOne(d *Deck) *DeckUpdateOne {
+	mutation := newDeckMutation(c.config, OpUpdateOne, withDeck(d))
+	return &DeckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeckClient) UpdateOneID(id int) *DeckUpdateOne {
+	mutation := newDeckMutation(c.config, OpUpdateOne, withDeckID(id))
+	return &DeckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Deck.
+func (c *DeckClient) Delete() *DeckDelete {
+	mutation := newDeckMutation(c.config, OpDelete)
+	return &DeckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *DeckClient) DeleteOne(d *Deck) *DeckDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *DeckClient) DeleteOneID(id int) *DeckDeleteOne {
+	builder := c.Delete().Where(deck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeckDeleteOne{builder}
+}
+
+// Query returns a query builder for Deck.
+func (c *DeckClient) Query() *DeckQuery {
+	return &DeckQuery{config: c.config}
+}
+
+// Get returns a Deck entity by its id.
+func (c *DeckClient) Get(ctx context.Context, id int) (*Deck, error) {
+	return c.Query().Where(deck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeckClient) GetX(ctx context.Context, id int) *Deck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Deck.
+func (c *DeckClient) QueryOwner(d *Deck) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deck.Table, deck.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deck.OwnerTable, deck.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeckClient) Hooks() []Hook {
+	return c.hooks.Deck
 }
 
 // GameClient is a client for the Game schema.
@@ -228,11 +360,251 @@ func (c *GameClient) QueryPlayers(ga *Game) *PlayerQuery {
 	return query
 }
 
+// QueryParticipants queries the participants edge of a Game.
+func (c *GameClient) QueryParticipants(ga *Game) *ParticipantQuery {
+	query := &ParticipantQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(participant.Table, participant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ParticipantsTable, game.ParticipantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *GameClient) Hooks() []Hook {
 	return c.hooks.Game
 }
 
+// OwnedCardClient is a client for the OwnedCard schema.
+type OwnedCardClient struct {
+	config
+}
+
+// NewOwnedCardClient returns a client for the OwnedCard from the given config.
+func NewOwnedCardClient(c config) *OwnedCardClient {
+	return &OwnedCardClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ownedcard.Hooks(f(g(h())))`.
+func (c *OwnedCardClient) Use(hooks ...Hook) {
+	c.hooks.OwnedCard = append(c.hooks.OwnedCard, hooks...)
+}
+
+// Create returns a create builder for OwnedCard.
+func (c *OwnedCardClient) Create() *OwnedCardCreate {
+	mutation := newOwnedCardMutation(c.config, OpCreate)
+	return &OwnedCardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OwnedCard entities.
+func (c *OwnedCardClient) CreateBulk(builders ...*OwnedCardCreate) *OwnedCardCreateBulk {
+	return &OwnedCardCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OwnedCard.
+func (c *OwnedCardClient) Update() *OwnedCardUpdate {
+	mutation := newOwnedCardMutation(c.config, OpUpdate)
+	return &OwnedCardUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OwnedCardClient) UpdateOne(oc *OwnedCard) *OwnedCardUpdateOne {
+	mutation := newOwnedCardMutation(c.config, OpUpdateOne, withOwnedCard(oc))
+	return &OwnedCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OwnedCardClient) UpdateOneID(id int) *OwnedCardUpdateOne {
+	mutation := newOwnedCardMutation(c.config, OpUpdateOne, withOwnedCardID(id))
+	return &OwnedCardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OwnedCard.
+func (c *OwnedCardClient) Delete() *OwnedCardDelete {
+	mutation := newOwnedCardMutation(c.config, OpDelete)
+	return &OwnedCardDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *OwnedCardClient) DeleteOne(oc *OwnedCard) *OwnedCardDeleteOne {
+	return c.DeleteOneID(oc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *OwnedCardClient) DeleteOneID(id int) *OwnedCardDeleteOne {
+	builder := c.Delete().Where(ownedcard.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OwnedCardDeleteOne{builder}
+}
+
+// Query returns a query builder for OwnedCard.
+func (c *OwnedCardClient) Query() *OwnedCardQuery {
+	return &OwnedCardQuery{config: c.config}
+}
+
+// Get returns a OwnedCard entity by its id.
+func (c *OwnedCardClient) Get(ctx context.Context, id int) (*OwnedCard, error) {
+	return c.Query().Where(ownedcard.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OwnedCardClient) GetX(ctx context.Context, id int) *OwnedCard {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a OwnedCard.
+func (c *OwnedCardClient) QueryOwner(oc *OwnedCard) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := oc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownedcard.Table, ownedcard.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownedcard.OwnerTable, ownedcard.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(oc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OwnedCardClient) Hooks() []Hook {
+	return c.hooks.OwnedCard
+}
+
+// ParticipantClient is a client for the Participant schema.
+type ParticipantClient struct {
+	config
+}
+
+// NewParticipantClient returns a client for the Participant from the given config.
+func NewParticipantClient(c config) *ParticipantClient {
+	return &ParticipantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `participant.Hooks(f(g(h())))`.
+func (c *ParticipantClient) Use(hooks ...Hook) {
+	c.hooks.Participant = append(c.hooks.Participant, hooks...)
+}
+
+// Create returns a create builder for Participant.
+func (c *ParticipantClient) Create() *ParticipantCreate {
+	mutation := newParticipantMutation(c.config, OpCreate)
+	return &ParticipantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Participant entities.
+func (c *ParticipantClient) CreateBulk(builders ...*ParticipantCreate) *ParticipantCreateBulk {
+	return &ParticipantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Participant.
+func (c *ParticipantClient) Update() *ParticipantUpdate {
+	mutation := newParticipantMutation(c.config, OpUpdate)
+	return &ParticipantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ParticipantClient) UpdateOne(pa *Participant) *ParticipantUpdateOne {
+	mutation := newParticipantMutation(c.config, OpUpdateOne, withParticipant(pa))
+	return &ParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ParticipantClient) UpdateOneID(id int) *ParticipantUpdateOne {
+	mutation := newParticipantMutation(c.config, OpUpdateOne, withParticipantID(id))
+	return &ParticipantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Participant.
+func (c *ParticipantClient) Delete() *ParticipantDelete {
+	mutation := newParticipantMutation(c.config, OpDelete)
+	return &ParticipantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ParticipantClient) DeleteOne(pa *Participant) *ParticipantDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ParticipantClient) DeleteOneID(id int) *ParticipantDeleteOne {
+	builder := c.Delete().Where(participant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ParticipantDeleteOne{builder}
+}
+
+// Query returns a query builder for Participant.
+func (c *ParticipantClient) Query() *ParticipantQuery {
+	return &ParticipantQuery{config: c.config}
+}
+
+// Get returns a Participant entity by its id.
+func (c *ParticipantClient) Get(ctx context.Context, id int) (*Participant, error) {
+	return c.Query().Where(participant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ParticipantClient) GetX(ctx context.Context, id int) *Participant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a Participant.
+func (c *ParticipantClient) QueryGame(pa *Participant) *GameQuery {
+	query := &GameQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(participant.Table, participant.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, participant.GameTable, participant.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlayer queries the player edge of a Participant.
+func (c *ParticipantClient) QueryPlayer(pa *Participant) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(participant.Table, participant.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, participant.PlayerTable, participant.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(pa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ParticipantClient) Hooks() []Hook {
+	return c.hooks.Participant
+}
+
 // PlayerClient is a client for the Player schema.
 type PlayerClient struct {
 	config
@@ -332,7 +704,175 @@ func (c *PlayerClient) QueryGame(pl *Player) *GameQuery {
 	return query
 }
 
+// QueryRatings queries the ratings edge of a Player.
+func (c *PlayerClient) QueryRatings(pl *Player) *RatingChangeQuery {
+	query := &RatingChangeQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(ratingchange.Table, ratingchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.RatingsTable, player.RatingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParticipations queries the participations edge of a Player.
+func (c *PlayerClient) QueryParticipations(pl *Player) *ParticipantQuery {
+	query := &ParticipantQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(participant.Table, participant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.ParticipationsTable, player.ParticipationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDecks queries the decks edge of a Player.
+func (c *PlayerClient) QueryDecks(pl *Player) *DeckQuery {
+	query := &DeckQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.DecksTable, player.DecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCards queries the cards edge of a Player.
+func (c *PlayerClient) QueryCards(pl *Player) *OwnedCardQuery {
+	query := &OwnedCardQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(ownedcard.Table, ownedcard.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.CardsTable, player.CardsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PlayerClient) Hooks() []Hook {
 	return c.hooks.Player
 }
+
+// RatingChangeClient is a client for the RatingChange schema.
+type RatingChangeClient struct {
+	config
+}
+
+// NewRatingChangeClient returns a client for the RatingChange from the given config.
+func NewRatingChangeClient(c config) *RatingChangeClient {
+	return &RatingChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratingchange.Hooks(f(g(h())))`.
+func (c *RatingChangeClient) Use(hooks ...Hook) {
+	c.hooks.RatingChange = append(c.hooks.RatingChange, hooks...)
+}
+
+// Create returns a create builder for RatingChange.
+func (c *RatingChangeClient) Create() *RatingChangeCreate {
+	mutation := newRatingChangeMutation(c.config, OpCreate)
+	return &RatingChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RatingChange entities.
+func (c *RatingChangeClient) CreateBulk(builders ...*RatingChangeCreate) *RatingChangeCreateBulk {
+	return &RatingChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RatingChange.
+func (c *RatingChangeClient) Update() *RatingChangeUpdate {
+	mutation := newRatingChangeMutation(c.config, OpUpdate)
+	return &RatingChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RatingChangeClient) UpdateOne(rc *RatingChange) *RatingChangeUpdateOne {
+	mutation := newRatingChangeMutation(c.config, OpUpdateOne, withRatingChange(rc))
+	return &RatingChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RatingChangeClient) UpdateOneID(id int) *RatingChangeUpdateOne {
+	mutation := newRatingChangeMutation(c.config, OpUpdateOne, withRatingChangeID(id))
+	return &RatingChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RatingChange.
+func (c *RatingChangeClient) Delete() *RatingChangeDelete {
+	mutation := newRatingChangeMutation(c.config, OpDelete)
+	return &RatingChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *RatingChangeClient) DeleteOne(rc *RatingChange) *RatingChangeDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *RatingChangeClient) DeleteOneID(id int) *RatingChangeDeleteOne {
+	builder := c.Delete().Where(ratingchange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RatingChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for RatingChange.
+func (c *RatingChangeClient) Query() *RatingChangeQuery {
+	return &RatingChangeQuery{config: c.config}
+}
+
+// Get returns a RatingChange entity by its id.
+func (c *RatingChangeClient) Get(ctx context.Context, id int) (*RatingChange, error) {
+	return c.Query().Where(ratingchange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RatingChangeClient) GetX(ctx context.Context, id int) *RatingChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayer queries the player edge of a RatingChange.
+func (c *RatingChangeClient) QueryPlayer(rc *RatingChange) *PlayerQuery {
+	query := &PlayerQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := rc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratingchange.Table, ratingchange.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratingchange.PlayerTable, ratingchange.PlayerColumn),
+		)
+		fromV = sqlgraph.Neighbors(rc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RatingChangeClient) Hooks() []Hook {
+	return c.hooks.RatingChange
+}
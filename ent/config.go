@@ -24,8 +24,12 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	Game   []ent.Hook
-	Player []ent.Hook
+	Deck         []ent.Hook
+	Game         []ent.Hook
+	OwnedCard    []ent.Hook
+	Participant  []ent.Hook
+	Player       []ent.Hook
+	RatingChange []ent.Hook
 }
 
 // Options applies the options on the config object.
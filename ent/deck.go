@@ -0,0 +1,180 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/deck"
+	"app/ent/player"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Deck is the model entity for the Deck schema.
+type Deck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Cards holds the value of the "cards" field.
+	Cards []uint32 `json:"cards,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeckQuery when eager-loading is set.
+	Edges        DeckEdges `json:"edges"`
+	player_decks *int
+}
+
+// DeckEdges holds the relations/edges for other nodes in the graph.
+type DeckEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Player `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeckEdges) OwnerOrErr() (*Player, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// The edge owner was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: player.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Deck) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deck.FieldCards:
+			values[i] = &[]byte{}
+		case deck.FieldID:
+			values[i] = &sql.NullInt64{}
+		case deck.FieldName:
+			values[i] = &sql.NullString{}
+		case deck.FieldCreatedAt, deck.FieldUpdatedAt:
+			values[i] = &sql.NullTime{}
+		case deck.ForeignKeys[0]: // player_decks
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Deck", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Deck fields.
+func (d *Deck) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deck.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case deck.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		case deck.FieldCards:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cards", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &d.Cards); err != nil {
+					return fmt.Errorf("unmarshal field cards: %v", err)
+				}
+			}
+		case deck.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case deck.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		case deck.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_decks", value)
+			} else if value.Valid {
+				d.player_decks = new(int)
+				*d.player_decks = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the Deck entity.
+func (d *Deck) QueryOwner() *PlayerQuery {
+	return (&DeckClient{config: d.config}).QueryOwner(d)
+}
+
+// Update returns a builder for updating this Deck.
+// Note that you need to call Deck.Unwrap() before calling this method if this Deck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Deck) Update() *DeckUpdateOne {
+	return (&DeckClient{config: d.config}).UpdateOne(d)
+}
+
+// Unwrap unwraps the Deck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Deck) Unwrap() *Deck {
+	tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Deck is not a transactional entity")
+	}
+	d.config.driver = tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Deck) String() string {
+	var builder strings.Builder
+	builder.WriteString("Deck(")
+	builder.WriteString(fmt.Sprintf("id=%v", d.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(d.Name)
+	builder.WriteString(", cards=")
+	builder.WriteString(fmt.Sprintf("%v", d.Cards))
+	builder.WriteString(", created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Decks is a parsable slice of Deck.
+type Decks []*Deck
+
+func (d Decks) config(cfg config) {
+	for _i := range d {
+		d[_i].config = cfg
+	}
+}
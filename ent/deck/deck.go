@@ -0,0 +1,75 @@
+// Code generated by entc, DO NOT EDIT.
+
+package deck
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the deck type in the database.
+	Label = "deck"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCards holds the string denoting the cards field in the database.
+	FieldCards = "cards"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+
+	// Table holds the table name of the deck in the database.
+	Table = "decks"
+	// OwnerTable is the table the holds the owner relation/edge.
+	OwnerTable = "decks"
+	// OwnerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	OwnerInverseTable = "players"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "player_decks"
+)
+
+// Columns holds all SQL columns for deck fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCards,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Deck type.
+var ForeignKeys = []string{
+	"player_decks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
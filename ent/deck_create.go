@@ -0,0 +1,305 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/deck"
+	"app/ent/player"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckCreate is the builder for creating a Deck entity.
+type DeckCreate struct {
+	config
+	mutation *DeckMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (dc *DeckCreate) SetName(s string) *DeckCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetCards sets the "cards" field.
+func (dc *DeckCreate) SetCards(u []uint32) *DeckCreate {
+	dc.mutation.SetCards(u)
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DeckCreate) SetCreatedAt(t time.Time) *DeckCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableCreatedAt(t *time.Time) *DeckCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DeckCreate) SetUpdatedAt(t time.Time) *DeckCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableUpdatedAt(t *time.Time) *DeckCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by ID.
+func (dc *DeckCreate) SetOwnerID(id int) *DeckCreate {
+	dc.mutation.SetOwnerID(id)
+	return dc
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (dc *DeckCreate) SetOwner(p *Player) *DeckCreate {
+	return dc.SetOwnerID(p.ID)
+}
+
+// Mutation returns the DeckMutation object of the builder.
+func (dc *DeckCreate) Mutation() *DeckMutation {
+	return dc.mutation
+}
+
+// Save creates the Deck in the database.
+func (dc *DeckCreate) Save(ctx context.Context) (*Deck, error) {
+	var (
+		err  error
+		node *Deck
+	)
+	dc.defaults()
+	if len(dc.hooks) == 0 {
+		if err = dc.check(); err != nil {
+			return nil, err
+		}
+		node, err = dc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeckMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = dc.check(); err != nil {
+				return nil, err
+			}
+			dc.mutation = mutation
+			node, err = dc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(dc.hooks) - 1; i >= 0; i-- {
+			mut = dc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeckCreate) SaveX(ctx context.Context) *Deck {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeckCreate) defaults() {
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := deck.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := deck.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeckCreate) check() error {
+	if _, ok := dc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := dc.mutation.Name(); ok {
+		if err := deck.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := dc.mutation.Cards(); !ok {
+		return &ValidationError{Name: "cards", err: errors.New("ent: missing required field \"cards\"")}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New("ent: missing required field \"updated_at\"")}
+	}
+	if _, ok := dc.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New("ent: missing required edge \"owner\"")}
+	}
+	return nil
+}
+
+func (dc *DeckCreate) sqlSave(ctx context.Context) (*Deck, error) {
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (dc *DeckCreate) createSpec() (*Deck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Deck{config: dc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: deck.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deck.FieldID,
+			},
+		}
+	)
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deck.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := dc.mutation.Cards(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: deck.FieldCards,
+		})
+		_node.Cards = value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deck.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deck.FieldUpdatedAt,
+		})
+		_node.UpdatedAt = value
+	}
+	if nodes := dc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.OwnerTable,
+			Columns: []string{deck.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DeckCreateBulk is the builder for creating many Deck entities in bulk.
+type DeckCreateBulk struct {
+	config
+	builders []*DeckCreate
+}
+
+// Save creates the Deck entities in the database.
+func (dcb *DeckCreateBulk) Save(ctx context.Context) ([]*Deck, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Deck, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeckCreateBulk) SaveX(ctx context.Context) []*Deck {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
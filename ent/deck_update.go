@@ -0,0 +1,453 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/deck"
+	"app/ent/player"
+	"app/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckUpdate is the builder for updating Deck entities.
+type DeckUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeckMutation
+}
+
+// Where adds a new predicate for the DeckUpdate builder.
+func (du *DeckUpdate) Where(ps ...predicate.Deck) *DeckUpdate {
+	du.mutation.predicates = append(du.mutation.predicates, ps...)
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DeckUpdate) SetName(s string) *DeckUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// SetCards sets the "cards" field.
+func (du *DeckUpdate) SetCards(u []uint32) *DeckUpdate {
+	du.mutation.SetCards(u)
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DeckUpdate) SetUpdatedAt(t time.Time) *DeckUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by ID.
+func (du *DeckUpdate) SetOwnerID(id int) *DeckUpdate {
+	du.mutation.SetOwnerID(id)
+	return du
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (du *DeckUpdate) SetOwner(p *Player) *DeckUpdate {
+	return du.SetOwnerID(p.ID)
+}
+
+// Mutation returns the DeckMutation object of the builder.
+func (du *DeckUpdate) Mutation() *DeckMutation {
+	return du.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (du *DeckUpdate) ClearOwner() *DeckUpdate {
+	du.mutation.ClearOwner()
+	return du
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeckUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	du.defaults()
+	if len(du.hooks) == 0 {
+		if err = du.check(); err != nil {
+			return 0, err
+		}
+		affected, err = du.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeckMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = du.check(); err != nil {
+				return 0, err
+			}
+			du.mutation = mutation
+			affected, err = du.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(du.hooks) - 1; i >= 0; i-- {
+			mut = du.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, du.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeckUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeckUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeckUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DeckUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := deck.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeckUpdate) check() error {
+	if v, ok := du.mutation.Name(); ok {
+		if err := deck.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := du.mutation.OwnerID(); du.mutation.OwnerCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"owner\"")
+	}
+	return nil
+}
+
+func (du *DeckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   deck.Table,
+			Columns: deck.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deck.FieldID,
+			},
+		},
+	}
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deck.FieldName,
+		})
+	}
+	if value, ok := du.mutation.Cards(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: deck.FieldCards,
+		})
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deck.FieldUpdatedAt,
+		})
+	}
+	if du.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.OwnerTable,
+			Columns: []string{deck.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.OwnerTable,
+			Columns: []string{deck.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deck.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// DeckUpdateOne is the builder for updating a single Deck entity.
+type DeckUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *DeckMutation
+}
+
+// SetName sets the "name" field.
+func (duo *DeckUpdateOne) SetName(s string) *DeckUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// SetCards sets the "cards" field.
+func (duo *DeckUpdateOne) SetCards(u []uint32) *DeckUpdateOne {
+	duo.mutation.SetCards(u)
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DeckUpdateOne) SetUpdatedAt(t time.Time) *DeckUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by ID.
+func (duo *DeckUpdateOne) SetOwnerID(id int) *DeckUpdateOne {
+	duo.mutation.SetOwnerID(id)
+	return duo
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (duo *DeckUpdateOne) SetOwner(p *Player) *DeckUpdateOne {
+	return duo.SetOwnerID(p.ID)
+}
+
+// Mutation returns the DeckMutation object of the builder.
+func (duo *DeckUpdateOne) Mutation() *DeckMutation {
+	return duo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (duo *DeckUpdateOne) ClearOwner() *DeckUpdateOne {
+	duo.mutation.ClearOwner()
+	return duo
+}
+
+// Save executes the query and returns the updated Deck entity.
+func (duo *DeckUpdateOne) Save(ctx context.Context) (*Deck, error) {
+	var (
+		err  error
+		node *Deck
+	)
+	duo.defaults()
+	if len(duo.hooks) == 0 {
+		if err = duo.check(); err != nil {
+			return nil, err
+		}
+		node, err = duo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeckMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = duo.check(); err != nil {
+				return nil, err
+			}
+			duo.mutation = mutation
+			node, err = duo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(duo.hooks) - 1; i >= 0; i-- {
+			mut = duo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, duo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeckUpdateOne) SaveX(ctx context.Context) *Deck {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeckUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeckUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DeckUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := deck.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeckUpdateOne) check() error {
+	if v, ok := duo.mutation.Name(); ok {
+		if err := deck.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := duo.mutation.OwnerID(); duo.mutation.OwnerCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"owner\"")
+	}
+	return nil
+}
+
+func (duo *DeckUpdateOne) sqlSave(ctx context.Context) (_node *Deck, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   deck.Table,
+			Columns: deck.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deck.FieldID,
+			},
+		},
+	}
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Deck.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: deck.FieldName,
+		})
+	}
+	if value, ok := duo.mutation.Cards(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: deck.FieldCards,
+		})
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: deck.FieldUpdatedAt,
+		})
+	}
+	if duo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.OwnerTable,
+			Columns: []string{deck.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.OwnerTable,
+			Columns: []string{deck.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Deck{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deck.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -19,6 +19,12 @@ type Game struct {
 	ID int `json:"id,omitempty"`
 	// Events holds the value of the "events" field.
 	Events []string `json:"events,omitempty"`
+	// Winner holds the value of the "winner" field.
+	Winner int `json:"winner,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration int `json:"duration,omitempty"`
+	// CatalogVersion holds the value of the "catalog_version" field.
+	CatalogVersion string `json:"catalog_version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -30,9 +36,11 @@ type Game struct {
 type GameEdges struct {
 	// Players holds the value of the players edge.
 	Players []*Player `json:"players,omitempty"`
+	// Participants holds the value of the participants edge.
+	Participants []*Participant `json:"participants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // PlayersOrErr returns the Players value or an error if the edge
@@ -44,6 +52,15 @@ func (e GameEdges) PlayersOrErr() ([]*Player, error) {
 	return nil, &NotLoadedError{edge: "players"}
 }
 
+// ParticipantsOrErr returns the Participants value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) ParticipantsOrErr() ([]*Participant, error) {
+	if e.loadedTypes[1] {
+		return e.Participants, nil
+	}
+	return nil, &NotLoadedError{edge: "participants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Game) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
@@ -51,8 +68,10 @@ func (*Game) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case game.FieldEvents:
 			values[i] = &[]byte{}
-		case game.FieldID:
+		case game.FieldID, game.FieldWinner, game.FieldDuration:
 			values[i] = &sql.NullInt64{}
+		case game.FieldCatalogVersion:
+			values[i] = &sql.NullString{}
 		case game.FieldCreatedAt:
 			values[i] = &sql.NullTime{}
 		default:
@@ -85,6 +104,24 @@ func (ga *Game) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field events: %v", err)
 				}
 			}
+		case game.FieldWinner:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field winner", values[i])
+			} else if value.Valid {
+				ga.Winner = int(value.Int64)
+			}
+		case game.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				ga.Duration = int(value.Int64)
+			}
+		case game.FieldCatalogVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field catalog_version", values[i])
+			} else if value.Valid {
+				ga.CatalogVersion = value.String
+			}
 		case game.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -101,6 +138,11 @@ func (ga *Game) QueryPlayers() *PlayerQuery {
 	return (&GameClient{config: ga.config}).QueryPlayers(ga)
 }
 
+// QueryParticipants queries the "participants" edge of the Game entity.
+func (ga *Game) QueryParticipants() *ParticipantQuery {
+	return (&GameClient{config: ga.config}).QueryParticipants(ga)
+}
+
 // Update returns a builder for updating this Game.
 // Note that you need to call Game.Unwrap() before calling this method if this Game
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -126,6 +168,12 @@ func (ga *Game) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", ga.ID))
 	builder.WriteString(", events=")
 	builder.WriteString(fmt.Sprintf("%v", ga.Events))
+	builder.WriteString(", winner=")
+	builder.WriteString(fmt.Sprintf("%v", ga.Winner))
+	builder.WriteString(", duration=")
+	builder.WriteString(fmt.Sprintf("%v", ga.Duration))
+	builder.WriteString(", catalog_version=")
+	builder.WriteString(ga.CatalogVersion)
 	builder.WriteString(", created_at=")
 	builder.WriteString(ga.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -13,11 +13,19 @@ const (
 	FieldID = "id"
 	// FieldEvents holds the string denoting the events field in the database.
 	FieldEvents = "events"
+	// FieldWinner holds the string denoting the winner field in the database.
+	FieldWinner = "winner"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldCatalogVersion holds the string denoting the catalog_version field in the database.
+	FieldCatalogVersion = "catalog_version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 
 	// EdgePlayers holds the string denoting the players edge name in mutations.
 	EdgePlayers = "players"
+	// EdgeParticipants holds the string denoting the participants edge name in mutations.
+	EdgeParticipants = "participants"
 
 	// Table holds the table name of the game in the database.
 	Table = "games"
@@ -28,12 +36,22 @@ const (
 	PlayersInverseTable = "players"
 	// PlayersColumn is the table column denoting the players relation/edge.
 	PlayersColumn = "game_players"
+	// ParticipantsTable is the table the holds the participants relation/edge.
+	ParticipantsTable = "participants"
+	// ParticipantsInverseTable is the table name for the Participant entity.
+	// It exists in this package in order to avoid circular dependency with the "participant" package.
+	ParticipantsInverseTable = "participants"
+	// ParticipantsColumn is the table column denoting the participants relation/edge.
+	ParticipantsColumn = "game_participants"
 )
 
 // Columns holds all SQL columns for game fields.
 var Columns = []string{
 	FieldID,
 	FieldEvents,
+	FieldWinner,
+	FieldDuration,
+	FieldCatalogVersion,
 	FieldCreatedAt,
 }
 
@@ -48,6 +66,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// WinnerValidator is a validator for the "winner" field. It is called by the builders before save.
+	WinnerValidator func(int) error
+	// DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	DurationValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
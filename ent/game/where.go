@@ -93,6 +93,27 @@ func IDLTE(id int) predicate.Game {
 	})
 }
 
+// Winner applies equality check predicate on the "winner" field. It's identical to WinnerEQ.
+func Winner(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWinner), v))
+	})
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuration), v))
+	})
+}
+
+// CatalogVersion applies equality check predicate on the "catalog_version" field. It's identical to CatalogVersionEQ.
+func CatalogVersion(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCatalogVersion), v))
+	})
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Game {
 	return predicate.Game(func(s *sql.Selector) {
@@ -114,6 +135,283 @@ func EventsNotNil() predicate.Game {
 	})
 }
 
+// WinnerEQ applies the EQ predicate on the "winner" field.
+func WinnerEQ(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWinner), v))
+	})
+}
+
+// WinnerNEQ applies the NEQ predicate on the "winner" field.
+func WinnerNEQ(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWinner), v))
+	})
+}
+
+// WinnerIn applies the In predicate on the "winner" field.
+func WinnerIn(vs ...int) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWinner), v...))
+	})
+}
+
+// WinnerNotIn applies the NotIn predicate on the "winner" field.
+func WinnerNotIn(vs ...int) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWinner), v...))
+	})
+}
+
+// WinnerGT applies the GT predicate on the "winner" field.
+func WinnerGT(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWinner), v))
+	})
+}
+
+// WinnerGTE applies the GTE predicate on the "winner" field.
+func WinnerGTE(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWinner), v))
+	})
+}
+
+// WinnerLT applies the LT predicate on the "winner" field.
+func WinnerLT(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWinner), v))
+	})
+}
+
+// WinnerLTE applies the LTE predicate on the "winner" field.
+func WinnerLTE(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWinner), v))
+	})
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuration), v))
+	})
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDuration), v))
+	})
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...int) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDuration), v...))
+	})
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...int) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDuration), v...))
+	})
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDuration), v))
+	})
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDuration), v))
+	})
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDuration), v))
+	})
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v int) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDuration), v))
+	})
+}
+
+// CatalogVersionEQ applies the EQ predicate on the "catalog_version" field.
+func CatalogVersionEQ(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionNEQ applies the NEQ predicate on the "catalog_version" field.
+func CatalogVersionNEQ(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionIn applies the In predicate on the "catalog_version" field.
+func CatalogVersionIn(vs ...string) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCatalogVersion), v...))
+	})
+}
+
+// CatalogVersionNotIn applies the NotIn predicate on the "catalog_version" field.
+func CatalogVersionNotIn(vs ...string) predicate.Game {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Game(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCatalogVersion), v...))
+	})
+}
+
+// CatalogVersionGT applies the GT predicate on the "catalog_version" field.
+func CatalogVersionGT(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionGTE applies the GTE predicate on the "catalog_version" field.
+func CatalogVersionGTE(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionLT applies the LT predicate on the "catalog_version" field.
+func CatalogVersionLT(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionLTE applies the LTE predicate on the "catalog_version" field.
+func CatalogVersionLTE(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionContains applies the Contains predicate on the "catalog_version" field.
+func CatalogVersionContains(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionHasPrefix applies the HasPrefix predicate on the "catalog_version" field.
+func CatalogVersionHasPrefix(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionHasSuffix applies the HasSuffix predicate on the "catalog_version" field.
+func CatalogVersionHasSuffix(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionIsNil applies the IsNil predicate on the "catalog_version" field.
+func CatalogVersionIsNil() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCatalogVersion)))
+	})
+}
+
+// CatalogVersionNotNil applies the NotNil predicate on the "catalog_version" field.
+func CatalogVersionNotNil() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCatalogVersion)))
+	})
+}
+
+// CatalogVersionEqualFold applies the EqualFold predicate on the "catalog_version" field.
+func CatalogVersionEqualFold(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCatalogVersion), v))
+	})
+}
+
+// CatalogVersionContainsFold applies the ContainsFold predicate on the "catalog_version" field.
+func CatalogVersionContainsFold(v string) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCatalogVersion), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Game {
 	return predicate.Game(func(s *sql.Selector) {
@@ -218,6 +516,34 @@ func HasPlayersWith(preds ...predicate.Player) predicate.Game {
 	})
 }
 
+// HasParticipants applies the HasEdge predicate on the "participants" edge.
+func HasParticipants() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ParticipantsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParticipantsWith applies the HasEdge predicate on the "participants" edge with a given conditions (other predicates).
+func HasParticipantsWith(preds ...predicate.Participant) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(ParticipantsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ParticipantsTable, ParticipantsColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Game) predicate.Game {
 	return predicate.Game(func(s *sql.Selector) {
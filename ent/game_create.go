@@ -4,6 +4,7 @@ package ent
 
 import (
 	"app/ent/game"
+	"app/ent/participant"
 	"app/ent/player"
 	"context"
 	"errors"
@@ -27,6 +28,32 @@ func (gc *GameCreate) SetEvents(s []string) *GameCreate {
 	return gc
 }
 
+// SetWinner sets the "winner" field.
+func (gc *GameCreate) SetWinner(i int) *GameCreate {
+	gc.mutation.SetWinner(i)
+	return gc
+}
+
+// SetDuration sets the "duration" field.
+func (gc *GameCreate) SetDuration(i int) *GameCreate {
+	gc.mutation.SetDuration(i)
+	return gc
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (gc *GameCreate) SetCatalogVersion(s string) *GameCreate {
+	gc.mutation.SetCatalogVersion(s)
+	return gc
+}
+
+// SetNillableCatalogVersion sets the "catalog_version" field if the given value is not nil.
+func (gc *GameCreate) SetNillableCatalogVersion(s *string) *GameCreate {
+	if s != nil {
+		gc.SetCatalogVersion(*s)
+	}
+	return gc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (gc *GameCreate) SetCreatedAt(t time.Time) *GameCreate {
 	gc.mutation.SetCreatedAt(t)
@@ -62,6 +89,21 @@ func (gc *GameCreate) AddPlayers(p ...*Player) *GameCreate {
 	return gc.AddPlayerIDs(ids...)
 }
 
+// AddParticipantIDs adds the "participants" edge to the Participant entity by IDs.
+func (gc *GameCreate) AddParticipantIDs(ids ...int) *GameCreate {
+	gc.mutation.AddParticipantIDs(ids...)
+	return gc
+}
+
+// AddParticipants adds the "participants" edges to the Participant entity.
+func (gc *GameCreate) AddParticipants(p ...*Participant) *GameCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gc.AddParticipantIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (gc *GameCreate) Mutation() *GameMutation {
 	return gc.mutation
@@ -122,6 +164,22 @@ func (gc *GameCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (gc *GameCreate) check() error {
+	if _, ok := gc.mutation.Winner(); !ok {
+		return &ValidationError{Name: "winner", err: errors.New("ent: missing required field \"winner\"")}
+	}
+	if v, ok := gc.mutation.Winner(); ok {
+		if err := game.WinnerValidator(v); err != nil {
+			return &ValidationError{Name: "winner", err: fmt.Errorf("ent: validator failed for field \"winner\": %w", err)}
+		}
+	}
+	if _, ok := gc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New("ent: missing required field \"duration\"")}
+	}
+	if v, ok := gc.mutation.Duration(); ok {
+		if err := game.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf("ent: validator failed for field \"duration\": %w", err)}
+		}
+	}
 	if _, ok := gc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
 	}
@@ -171,6 +229,30 @@ func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		})
 		_node.Events = value
 	}
+	if value, ok := gc.mutation.Winner(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldWinner,
+		})
+		_node.Winner = value
+	}
+	if value, ok := gc.mutation.Duration(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldDuration,
+		})
+		_node.Duration = value
+	}
+	if value, ok := gc.mutation.CatalogVersion(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: game.FieldCatalogVersion,
+		})
+		_node.CatalogVersion = value
+	}
 	if value, ok := gc.mutation.CreatedAt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -198,6 +280,25 @@ func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
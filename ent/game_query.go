@@ -4,6 +4,7 @@ package ent
 
 import (
 	"app/ent/game"
+	"app/ent/participant"
 	"app/ent/player"
 	"app/ent/predicate"
 	"context"
@@ -26,7 +27,8 @@ type GameQuery struct {
 	fields     []string
 	predicates []predicate.Game
 	// eager-loading edges.
-	withPlayers *PlayerQuery
+	withPlayers      *PlayerQuery
+	withParticipants *ParticipantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -78,6 +80,28 @@ func (gq *GameQuery) QueryPlayers() *PlayerQuery {
 	return query
 }
 
+// QueryParticipants chains the current query on the "participants" edge.
+func (gq *GameQuery) QueryParticipants() *ParticipantQuery {
+	query := &ParticipantQuery{config: gq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(participant.Table, participant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, game.ParticipantsTable, game.ParticipantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Game entity from the query.
 // Returns a *NotFoundError when no Game was found.
 func (gq *GameQuery) First(ctx context.Context) (*Game, error) {
@@ -254,12 +278,13 @@ func (gq *GameQuery) Clone() *GameQuery {
 		return nil
 	}
 	return &GameQuery{
-		config:      gq.config,
-		limit:       gq.limit,
-		offset:      gq.offset,
-		order:       append([]OrderFunc{}, gq.order...),
-		predicates:  append([]predicate.Game{}, gq.predicates...),
-		withPlayers: gq.withPlayers.Clone(),
+		config:           gq.config,
+		limit:            gq.limit,
+		offset:           gq.offset,
+		order:            append([]OrderFunc{}, gq.order...),
+		predicates:       append([]predicate.Game{}, gq.predicates...),
+		withPlayers:      gq.withPlayers.Clone(),
+		withParticipants: gq.withParticipants.Clone(),
 		// clone intermediate query.
 		sql:  gq.sql.Clone(),
 		path: gq.path,
@@ -277,6 +302,17 @@ func (gq *GameQuery) WithPlayers(opts ...func(*PlayerQuery)) *GameQuery {
 	return gq
 }
 
+// WithParticipants tells the query-builder to eager-load the nodes that are connected to
+// the "participants" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithParticipants(opts ...func(*ParticipantQuery)) *GameQuery {
+	query := &ParticipantQuery{config: gq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withParticipants = query
+	return gq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -342,8 +378,9 @@ func (gq *GameQuery) sqlAll(ctx context.Context) ([]*Game, error) {
 	var (
 		nodes       = []*Game{}
 		_spec       = gq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			gq.withPlayers != nil,
+			gq.withParticipants != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
@@ -395,6 +432,35 @@ func (gq *GameQuery) sqlAll(ctx context.Context) ([]*Game, error) {
 		}
 	}
 
+	if query := gq.withParticipants; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Game)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Participants = []*Participant{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Participant(func(s *sql.Selector) {
+			s.Where(sql.InValues(game.ParticipantsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.game_participants
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "game_participants" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "game_participants" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Participants = append(node.Edges.Participants, n)
+		}
+	}
+
 	return nodes, nil
 }
 
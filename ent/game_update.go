@@ -4,6 +4,7 @@ package ent
 
 import (
 	"app/ent/game"
+	"app/ent/participant"
 	"app/ent/player"
 	"app/ent/predicate"
 	"context"
@@ -40,6 +41,52 @@ func (gu *GameUpdate) ClearEvents() *GameUpdate {
 	return gu
 }
 
+// SetWinner sets the "winner" field.
+func (gu *GameUpdate) SetWinner(i int) *GameUpdate {
+	gu.mutation.ResetWinner()
+	gu.mutation.SetWinner(i)
+	return gu
+}
+
+// AddWinner adds i to the "winner" field.
+func (gu *GameUpdate) AddWinner(i int) *GameUpdate {
+	gu.mutation.AddWinner(i)
+	return gu
+}
+
+// SetDuration sets the "duration" field.
+func (gu *GameUpdate) SetDuration(i int) *GameUpdate {
+	gu.mutation.ResetDuration()
+	gu.mutation.SetDuration(i)
+	return gu
+}
+
+// AddDuration adds i to the "duration" field.
+func (gu *GameUpdate) AddDuration(i int) *GameUpdate {
+	gu.mutation.AddDuration(i)
+	return gu
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (gu *GameUpdate) SetCatalogVersion(s string) *GameUpdate {
+	gu.mutation.SetCatalogVersion(s)
+	return gu
+}
+
+// SetNillableCatalogVersion sets the "catalog_version" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableCatalogVersion(s *string) *GameUpdate {
+	if s != nil {
+		gu.SetCatalogVersion(*s)
+	}
+	return gu
+}
+
+// ClearCatalogVersion clears the value of the "catalog_version" field.
+func (gu *GameUpdate) ClearCatalogVersion() *GameUpdate {
+	gu.mutation.ClearCatalogVersion()
+	return gu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (gu *GameUpdate) SetCreatedAt(t time.Time) *GameUpdate {
 	gu.mutation.SetCreatedAt(t)
@@ -69,6 +116,21 @@ func (gu *GameUpdate) AddPlayers(p ...*Player) *GameUpdate {
 	return gu.AddPlayerIDs(ids...)
 }
 
+// AddParticipantIDs adds the "participants" edge to the Participant entity by IDs.
+func (gu *GameUpdate) AddParticipantIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddParticipantIDs(ids...)
+	return gu
+}
+
+// AddParticipants adds the "participants" edges to the Participant entity.
+func (gu *GameUpdate) AddParticipants(p ...*Participant) *GameUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.AddParticipantIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (gu *GameUpdate) Mutation() *GameMutation {
 	return gu.mutation
@@ -95,6 +157,27 @@ func (gu *GameUpdate) RemovePlayers(p ...*Player) *GameUpdate {
 	return gu.RemovePlayerIDs(ids...)
 }
 
+// ClearParticipants clears all "participants" edges to the Participant entity.
+func (gu *GameUpdate) ClearParticipants() *GameUpdate {
+	gu.mutation.ClearParticipants()
+	return gu
+}
+
+// RemoveParticipantIDs removes the "participants" edge to Participant entities by IDs.
+func (gu *GameUpdate) RemoveParticipantIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveParticipantIDs(ids...)
+	return gu
+}
+
+// RemoveParticipants removes "participants" edges to Participant entities.
+func (gu *GameUpdate) RemoveParticipants(p ...*Participant) *GameUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return gu.RemoveParticipantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GameUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -102,6 +185,9 @@ func (gu *GameUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(gu.hooks) == 0 {
+		if err = gu.check(); err != nil {
+			return 0, err
+		}
 		affected, err = gu.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -109,6 +195,9 @@ func (gu *GameUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = gu.check(); err != nil {
+				return 0, err
+			}
 			gu.mutation = mutation
 			affected, err = gu.sqlSave(ctx)
 			mutation.done = true
@@ -146,6 +235,21 @@ func (gu *GameUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (gu *GameUpdate) check() error {
+	if v, ok := gu.mutation.Winner(); ok {
+		if err := game.WinnerValidator(v); err != nil {
+			return &ValidationError{Name: "winner", err: fmt.Errorf("ent: validator failed for field \"winner\": %w", err)}
+		}
+	}
+	if v, ok := gu.mutation.Duration(); ok {
+		if err := game.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf("ent: validator failed for field \"duration\": %w", err)}
+		}
+	}
+	return nil
+}
+
 func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -177,6 +281,47 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: game.FieldEvents,
 		})
 	}
+	if value, ok := gu.mutation.Winner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldWinner,
+		})
+	}
+	if value, ok := gu.mutation.AddedWinner(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldWinner,
+		})
+	}
+	if value, ok := gu.mutation.Duration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldDuration,
+		})
+	}
+	if value, ok := gu.mutation.AddedDuration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldDuration,
+		})
+	}
+	if value, ok := gu.mutation.CatalogVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: game.FieldCatalogVersion,
+		})
+	}
+	if gu.mutation.CatalogVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: game.FieldCatalogVersion,
+		})
+	}
 	if value, ok := gu.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -238,6 +383,60 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !gu.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{game.Label}
@@ -268,6 +467,52 @@ func (guo *GameUpdateOne) ClearEvents() *GameUpdateOne {
 	return guo
 }
 
+// SetWinner sets the "winner" field.
+func (guo *GameUpdateOne) SetWinner(i int) *GameUpdateOne {
+	guo.mutation.ResetWinner()
+	guo.mutation.SetWinner(i)
+	return guo
+}
+
+// AddWinner adds i to the "winner" field.
+func (guo *GameUpdateOne) AddWinner(i int) *GameUpdateOne {
+	guo.mutation.AddWinner(i)
+	return guo
+}
+
+// SetDuration sets the "duration" field.
+func (guo *GameUpdateOne) SetDuration(i int) *GameUpdateOne {
+	guo.mutation.ResetDuration()
+	guo.mutation.SetDuration(i)
+	return guo
+}
+
+// AddDuration adds i to the "duration" field.
+func (guo *GameUpdateOne) AddDuration(i int) *GameUpdateOne {
+	guo.mutation.AddDuration(i)
+	return guo
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (guo *GameUpdateOne) SetCatalogVersion(s string) *GameUpdateOne {
+	guo.mutation.SetCatalogVersion(s)
+	return guo
+}
+
+// SetNillableCatalogVersion sets the "catalog_version" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableCatalogVersion(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetCatalogVersion(*s)
+	}
+	return guo
+}
+
+// ClearCatalogVersion clears the value of the "catalog_version" field.
+func (guo *GameUpdateOne) ClearCatalogVersion() *GameUpdateOne {
+	guo.mutation.ClearCatalogVersion()
+	return guo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (guo *GameUpdateOne) SetCreatedAt(t time.Time) *GameUpdateOne {
 	guo.mutation.SetCreatedAt(t)
@@ -297,6 +542,21 @@ func (guo *GameUpdateOne) AddPlayers(p ...*Player) *GameUpdateOne {
 	return guo.AddPlayerIDs(ids...)
 }
 
+// AddParticipantIDs adds the "participants" edge to the Participant entity by IDs.
+func (guo *GameUpdateOne) AddParticipantIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddParticipantIDs(ids...)
+	return guo
+}
+
+// AddParticipants adds the "participants" edges to the Participant entity.
+func (guo *GameUpdateOne) AddParticipants(p ...*Participant) *GameUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return guo.AddParticipantIDs(ids...)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (guo *GameUpdateOne) Mutation() *GameMutation {
 	return guo.mutation
@@ -323,6 +583,27 @@ func (guo *GameUpdateOne) RemovePlayers(p ...*Player) *GameUpdateOne {
 	return guo.RemovePlayerIDs(ids...)
 }
 
+// ClearParticipants clears all "participants" edges to the Participant entity.
+func (guo *GameUpdateOne) ClearParticipants() *GameUpdateOne {
+	guo.mutation.ClearParticipants()
+	return guo
+}
+
+// RemoveParticipantIDs removes the "participants" edge to Participant entities by IDs.
+func (guo *GameUpdateOne) RemoveParticipantIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveParticipantIDs(ids...)
+	return guo
+}
+
+// RemoveParticipants removes "participants" edges to Participant entities.
+func (guo *GameUpdateOne) RemoveParticipants(p ...*Participant) *GameUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return guo.RemoveParticipantIDs(ids...)
+}
+
 // Save executes the query and returns the updated Game entity.
 func (guo *GameUpdateOne) Save(ctx context.Context) (*Game, error) {
 	var (
@@ -330,6 +611,9 @@ func (guo *GameUpdateOne) Save(ctx context.Context) (*Game, error) {
 		node *Game
 	)
 	if len(guo.hooks) == 0 {
+		if err = guo.check(); err != nil {
+			return nil, err
+		}
 		node, err = guo.sqlSave(ctx)
 	} else {
 		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
@@ -337,6 +621,9 @@ func (guo *GameUpdateOne) Save(ctx context.Context) (*Game, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
+			if err = guo.check(); err != nil {
+				return nil, err
+			}
 			guo.mutation = mutation
 			node, err = guo.sqlSave(ctx)
 			mutation.done = true
@@ -374,6 +661,21 @@ func (guo *GameUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (guo *GameUpdateOne) check() error {
+	if v, ok := guo.mutation.Winner(); ok {
+		if err := game.WinnerValidator(v); err != nil {
+			return &ValidationError{Name: "winner", err: fmt.Errorf("ent: validator failed for field \"winner\": %w", err)}
+		}
+	}
+	if v, ok := guo.mutation.Duration(); ok {
+		if err := game.DurationValidator(v); err != nil {
+			return &ValidationError{Name: "duration", err: fmt.Errorf("ent: validator failed for field \"duration\": %w", err)}
+		}
+	}
+	return nil
+}
+
 func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error) {
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
@@ -410,6 +712,47 @@ func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error)
 			Column: game.FieldEvents,
 		})
 	}
+	if value, ok := guo.mutation.Winner(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldWinner,
+		})
+	}
+	if value, ok := guo.mutation.AddedWinner(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldWinner,
+		})
+	}
+	if value, ok := guo.mutation.Duration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldDuration,
+		})
+	}
+	if value, ok := guo.mutation.AddedDuration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: game.FieldDuration,
+		})
+	}
+	if value, ok := guo.mutation.CatalogVersion(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: game.FieldCatalogVersion,
+		})
+	}
+	if guo.mutation.CatalogVersionCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: game.FieldCatalogVersion,
+		})
+	}
 	if value, ok := guo.mutation.CreatedAt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -471,6 +814,60 @@ func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedParticipantsIDs(); len(nodes) > 0 && !guo.mutation.ParticipantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.ParticipantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   game.ParticipantsTable,
+			Columns: []string{game.ParticipantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Game{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -8,6 +8,19 @@ import (
 	"fmt"
 )
 
+// The DeckFunc type is an adapter to allow the use of ordinary
+// function as Deck mutator.
+type DeckFunc func(context.Context, *ent.DeckMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeckFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.DeckMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeckMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The GameFunc type is an adapter to allow the use of ordinary
 // function as Game mutator.
 type GameFunc func(context.Context, *ent.GameMutation) (ent.Value, error)
@@ -21,6 +34,32 @@ func (f GameFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return f(ctx, mv)
 }
 
+// The OwnedCardFunc type is an adapter to allow the use of ordinary
+// function as OwnedCard mutator.
+type OwnedCardFunc func(context.Context, *ent.OwnedCardMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OwnedCardFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.OwnedCardMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OwnedCardMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ParticipantFunc type is an adapter to allow the use of ordinary
+// function as Participant mutator.
+type ParticipantFunc func(context.Context, *ent.ParticipantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ParticipantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ParticipantMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ParticipantMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The PlayerFunc type is an adapter to allow the use of ordinary
 // function as Player mutator.
 type PlayerFunc func(context.Context, *ent.PlayerMutation) (ent.Value, error)
@@ -34,6 +73,19 @@ func (f PlayerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return f(ctx, mv)
 }
 
+// The RatingChangeFunc type is an adapter to allow the use of ordinary
+// function as RatingChange mutator.
+type RatingChangeFunc func(context.Context, *ent.RatingChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RatingChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.RatingChangeMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RatingChangeMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -8,10 +8,37 @@ import (
 )
 
 var (
+	// DecksColumns holds the columns for the "decks" table.
+	DecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 20},
+		{Name: "cards", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "player_decks", Type: field.TypeInt, Nullable: true},
+	}
+	// DecksTable holds the schema information for the "decks" table.
+	DecksTable = &schema.Table{
+		Name:       "decks",
+		Columns:    DecksColumns,
+		PrimaryKey: []*schema.Column{DecksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "decks_players_decks",
+				Columns: []*schema.Column{DecksColumns[5]},
+
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// GamesColumns holds the columns for the "games" table.
 	GamesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "winner", Type: field.TypeInt},
+		{Name: "duration", Type: field.TypeInt},
+		{Name: "catalog_version", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// GamesTable holds the schema information for the "games" table.
@@ -21,11 +48,80 @@ var (
 		PrimaryKey:  []*schema.Column{GamesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
 	}
+	// OwnedCardsColumns holds the columns for the "owned_cards" table.
+	OwnedCardsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "card", Type: field.TypeInt},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"starter", "unlock", "admin"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "player_cards", Type: field.TypeInt, Nullable: true},
+	}
+	// OwnedCardsTable holds the schema information for the "owned_cards" table.
+	OwnedCardsTable = &schema.Table{
+		Name:       "owned_cards",
+		Columns:    OwnedCardsColumns,
+		PrimaryKey: []*schema.Column{OwnedCardsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "owned_cards_players_cards",
+				Columns: []*schema.Column{OwnedCardsColumns[4]},
+
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ownedcard_card_player_cards",
+				Unique:  true,
+				Columns: []*schema.Column{OwnedCardsColumns[1], OwnedCardsColumns[4]},
+			},
+		},
+	}
+	// ParticipantsColumns holds the columns for the "participants" table.
+	ParticipantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "team", Type: field.TypeInt},
+		{Name: "deck", Type: field.TypeJSON, Nullable: true},
+		{Name: "left", Type: field.TypeBool},
+		{Name: "game_participants", Type: field.TypeInt, Nullable: true},
+		{Name: "player_participations", Type: field.TypeInt, Nullable: true},
+	}
+	// ParticipantsTable holds the schema information for the "participants" table.
+	ParticipantsTable = &schema.Table{
+		Name:       "participants",
+		Columns:    ParticipantsColumns,
+		PrimaryKey: []*schema.Column{ParticipantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "participants_games_participants",
+				Columns: []*schema.Column{ParticipantsColumns[5]},
+
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "participants_players_participations",
+				Columns: []*schema.Column{ParticipantsColumns[6]},
+
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// PlayersColumns holds the columns for the "players" table.
 	PlayersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "type", Type: field.TypeInt},
 		{Name: "name", Type: field.TypeString, Unique: true, Size: 20},
+		{Name: "rating", Type: field.TypeInt, Default: 1000},
+		{Name: "defender_rating", Type: field.TypeInt, Default: 1000},
+		{Name: "attacker_rating", Type: field.TypeInt, Default: 1000},
+		{Name: "semo_rating", Type: field.TypeInt, Default: 1000},
+		{Name: "experience", Type: field.TypeInt},
+		{Name: "starter_granted", Type: field.TypeBool},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
 		{Name: "game_players", Type: field.TypeInt, Nullable: true},
 	}
 	// PlayersTable holds the schema information for the "players" table.
@@ -36,20 +132,54 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:  "players_games_players",
-				Columns: []*schema.Column{PlayersColumns[3]},
+				Columns: []*schema.Column{PlayersColumns[10]},
 
 				RefColumns: []*schema.Column{GamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// RatingChangesColumns holds the columns for the "rating_changes" table.
+	RatingChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "role", Type: field.TypeInt},
+		{Name: "before", Type: field.TypeInt},
+		{Name: "after", Type: field.TypeInt},
+		{Name: "won", Type: field.TypeBool},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "player_ratings", Type: field.TypeInt, Nullable: true},
+	}
+	// RatingChangesTable holds the schema information for the "rating_changes" table.
+	RatingChangesTable = &schema.Table{
+		Name:       "rating_changes",
+		Columns:    RatingChangesColumns,
+		PrimaryKey: []*schema.Column{RatingChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "rating_changes_players_ratings",
+				Columns: []*schema.Column{RatingChangesColumns[6]},
+
+				RefColumns: []*schema.Column{PlayersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DecksTable,
 		GamesTable,
+		OwnedCardsTable,
+		ParticipantsTable,
 		PlayersTable,
+		RatingChangesTable,
 	}
 )
 
 func init() {
+	DecksTable.ForeignKeys[0].RefTable = PlayersTable
+	OwnedCardsTable.ForeignKeys[0].RefTable = PlayersTable
+	ParticipantsTable.ForeignKeys[0].RefTable = GamesTable
+	ParticipantsTable.ForeignKeys[1].RefTable = PlayersTable
 	PlayersTable.ForeignKeys[0].RefTable = GamesTable
+	RatingChangesTable.ForeignKeys[0].RefTable = PlayersTable
 }
@@ -3,9 +3,13 @@
 package ent
 
 import (
+	"app/ent/deck"
 	"app/ent/game"
+	"app/ent/ownedcard"
+	"app/ent/participant"
 	"app/ent/player"
 	"app/ent/predicate"
+	"app/ent/ratingchange"
 	"context"
 	"fmt"
 	"sync"
@@ -23,38 +27,43 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeGame   = "Game"
-	TypePlayer = "Player"
+	TypeDeck         = "Deck"
+	TypeGame         = "Game"
+	TypeOwnedCard    = "OwnedCard"
+	TypeParticipant  = "Participant"
+	TypePlayer       = "Player"
+	TypeRatingChange = "RatingChange"
 )
 
-// GameMutation represents an operation that mutates the Game nodes in the graph.
-type GameMutation struct {
+// DeckMutation represents an operation that mutates the Deck nodes in the graph.
+type DeckMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	events         *[]string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	players        map[int]struct{}
-	removedplayers map[int]struct{}
-	clearedplayers bool
-	done           bool
-	oldValue       func(context.Context) (*Game, error)
-	predicates     []predicate.Game
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	cards         *[]uint32
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*Deck, error)
+	predicates    []predicate.Deck
 }
 
-var _ ent.Mutation = (*GameMutation)(nil)
+var _ ent.Mutation = (*DeckMutation)(nil)
 
-// gameOption allows management of the mutation configuration using functional options.
-type gameOption func(*GameMutation)
+// deckOption allows management of the mutation configuration using functional options.
+type deckOption func(*DeckMutation)
 
-// newGameMutation creates new mutation for the Game entity.
-func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
-	m := &GameMutation{
+// newDeckMutation creates new mutation for the Deck entity.
+func newDeckMutation(c config, op Op, opts ...deckOption) *DeckMutation {
+	m := &DeckMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGame,
+		typ:           TypeDeck,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -63,20 +72,20 @@ func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
 	return m
 }
 
-// withGameID sets the ID field of the mutation.
-func withGameID(id int) gameOption {
-	return func(m *GameMutation) {
+// withDeckID sets the ID field of the mutation.
+func withDeckID(id int) deckOption {
+	return func(m *DeckMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Game
+			value *Deck
 		)
-		m.oldValue = func(ctx context.Context) (*Game, error) {
+		m.oldValue = func(ctx context.Context) (*Deck, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Game.Get(ctx, id)
+					value, err = m.Client().Deck.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -85,10 +94,10 @@ func withGameID(id int) gameOption {
 	}
 }
 
-// withGame sets the old Game of the mutation.
-func withGame(node *Game) gameOption {
-	return func(m *GameMutation) {
-		m.oldValue = func(context.Context) (*Game, error) {
+// withDeck sets the old Deck of the mutation.
+func withDeck(node *Deck) deckOption {
+	return func(m *DeckMutation) {
+		m.oldValue = func(context.Context) (*Deck, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -97,7 +106,7 @@ func withGame(node *Game) gameOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GameMutation) Client() *Client {
+func (m DeckMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -105,7 +114,7 @@ func (m GameMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GameMutation) Tx() (*Tx, error) {
+func (m DeckMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -114,77 +123,94 @@ func (m GameMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Game entities.
-func (m *GameMutation) SetID(id int) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID
 // is only available if it was provided to the builder.
-func (m *GameMutation) ID() (id int, exists bool) {
+func (m *DeckMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetEvents sets the "events" field.
-func (m *GameMutation) SetEvents(s []string) {
-	m.events = &s
+// SetName sets the "name" field.
+func (m *DeckMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Events returns the value of the "events" field in the mutation.
-func (m *GameMutation) Events() (r []string, exists bool) {
-	v := m.events
+// Name returns the value of the "name" field in the mutation.
+func (m *DeckMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEvents returns the old "events" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldEvents(ctx context.Context) (v []string, err error) {
+func (m *DeckMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldEvents is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Events, nil
+	return oldValue.Name, nil
 }
 
-// ClearEvents clears the value of the "events" field.
-func (m *GameMutation) ClearEvents() {
-	m.events = nil
-	m.clearedFields[game.FieldEvents] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *DeckMutation) ResetName() {
+	m.name = nil
 }
 
-// EventsCleared returns if the "events" field was cleared in this mutation.
-func (m *GameMutation) EventsCleared() bool {
-	_, ok := m.clearedFields[game.FieldEvents]
-	return ok
+// SetCards sets the "cards" field.
+func (m *DeckMutation) SetCards(u []uint32) {
+	m.cards = &u
 }
 
-// ResetEvents resets all changes to the "events" field.
-func (m *GameMutation) ResetEvents() {
-	m.events = nil
-	delete(m.clearedFields, game.FieldEvents)
+// Cards returns the value of the "cards" field in the mutation.
+func (m *DeckMutation) Cards() (r []uint32, exists bool) {
+	v := m.cards
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCards returns the old "cards" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeckMutation) OldCards(ctx context.Context) (v []uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCards is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCards requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCards: %w", err)
+	}
+	return oldValue.Cards, nil
+}
+
+// ResetCards resets all changes to the "cards" field.
+func (m *DeckMutation) ResetCards() {
+	m.cards = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *GameMutation) SetCreatedAt(t time.Time) {
+func (m *DeckMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *GameMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeckMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -192,10 +218,10 @@ func (m *GameMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeckMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -210,83 +236,111 @@ func (m *GameMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *GameMutation) ResetCreatedAt() {
+func (m *DeckMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// AddPlayerIDs adds the "players" edge to the Player entity by ids.
-func (m *GameMutation) AddPlayerIDs(ids ...int) {
-	if m.players == nil {
-		m.players = make(map[int]struct{})
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeckMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeckMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.players[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeckMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUpdatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearPlayers clears the "players" edge to the Player entity.
-func (m *GameMutation) ClearPlayers() {
-	m.clearedplayers = true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeckMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// PlayersCleared returns if the "players" edge to the Player entity was cleared.
-func (m *GameMutation) PlayersCleared() bool {
-	return m.clearedplayers
+// SetOwnerID sets the "owner" edge to the Player entity by id.
+func (m *DeckMutation) SetOwnerID(id int) {
+	m.owner = &id
 }
 
-// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
-func (m *GameMutation) RemovePlayerIDs(ids ...int) {
-	if m.removedplayers == nil {
-		m.removedplayers = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.removedplayers[ids[i]] = struct{}{}
-	}
+// ClearOwner clears the "owner" edge to the Player entity.
+func (m *DeckMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
-func (m *GameMutation) RemovedPlayersIDs() (ids []int) {
-	for id := range m.removedplayers {
-		ids = append(ids, id)
+// OwnerCleared returns if the "owner" edge to the Player entity was cleared.
+func (m *DeckMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *DeckMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
 	return
 }
 
-// PlayersIDs returns the "players" edge IDs in the mutation.
-func (m *GameMutation) PlayersIDs() (ids []int) {
-	for id := range m.players {
-		ids = append(ids, id)
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *DeckMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPlayers resets all changes to the "players" edge.
-func (m *GameMutation) ResetPlayers() {
-	m.players = nil
-	m.clearedplayers = false
-	m.removedplayers = nil
+// ResetOwner resets all changes to the "owner" edge.
+func (m *DeckMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
 // Op returns the operation name.
-func (m *GameMutation) Op() Op {
+func (m *DeckMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Game).
-func (m *GameMutation) Type() string {
+// Type returns the node type of this mutation (Deck).
+func (m *DeckMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GameMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.events != nil {
-		fields = append(fields, game.FieldEvents)
+func (m *DeckMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, deck.FieldName)
+	}
+	if m.cards != nil {
+		fields = append(fields, deck.FieldCards)
 	}
 	if m.created_at != nil {
-		fields = append(fields, game.FieldCreatedAt)
+		fields = append(fields, deck.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deck.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -294,12 +348,16 @@ func (m *GameMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GameMutation) Field(name string) (ent.Value, bool) {
+func (m *DeckMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case game.FieldEvents:
-		return m.Events()
-	case game.FieldCreatedAt:
+	case deck.FieldName:
+		return m.Name()
+	case deck.FieldCards:
+		return m.Cards()
+	case deck.FieldCreatedAt:
 		return m.CreatedAt()
+	case deck.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -307,215 +365,3513 @@ func (m *GameMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeckMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case game.FieldEvents:
-		return m.OldEvents(ctx)
-	case game.FieldCreatedAt:
+	case deck.FieldName:
+		return m.OldName(ctx)
+	case deck.FieldCards:
+		return m.OldCards(ctx)
+	case deck.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case deck.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Game field %s", name)
+	return nil, fmt.Errorf("unknown Deck field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameMutation) SetField(name string, value ent.Value) error {
+func (m *DeckMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case game.FieldEvents:
-		v, ok := value.([]string)
+	case deck.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEvents(v)
+		m.SetName(v)
 		return nil
-	case game.FieldCreatedAt:
+	case deck.FieldCards:
+		v, ok := value.([]uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCards(v)
+		return nil
+	case deck.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case deck.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Game field %s", name)
+	return fmt.Errorf("unknown Deck field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GameMutation) AddedFields() []string {
+func (m *DeckMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeckMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameMutation) AddField(name string, value ent.Value) error {
+func (m *DeckMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Game numeric field %s", name)
+	return fmt.Errorf("unknown Deck numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GameMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(game.FieldEvents) {
-		fields = append(fields, game.FieldEvents)
-	}
-	return fields
+func (m *DeckMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GameMutation) FieldCleared(name string) bool {
+func (m *DeckMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GameMutation) ClearField(name string) error {
-	switch name {
-	case game.FieldEvents:
-		m.ClearEvents()
-		return nil
-	}
-	return fmt.Errorf("unknown Game nullable field %s", name)
+func (m *DeckMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Deck nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GameMutation) ResetField(name string) error {
+func (m *DeckMutation) ResetField(name string) error {
 	switch name {
-	case game.FieldEvents:
-		m.ResetEvents()
+	case deck.FieldName:
+		m.ResetName()
 		return nil
-	case game.FieldCreatedAt:
+	case deck.FieldCards:
+		m.ResetCards()
+		return nil
+	case deck.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case deck.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Game field %s", name)
+	return fmt.Errorf("unknown Deck field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GameMutation) AddedEdges() []string {
+func (m *DeckMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.players != nil {
-		edges = append(edges, game.EdgePlayers)
+	if m.owner != nil {
+		edges = append(edges, deck.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GameMutation) AddedIDs(name string) []ent.Value {
+func (m *DeckMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case game.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.players))
-		for id := range m.players {
-			ids = append(ids, id)
+	case deck.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GameMutation) RemovedEdges() []string {
+func (m *DeckMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedplayers != nil {
-		edges = append(edges, game.EdgePlayers)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GameMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeckMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case game.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.removedplayers))
-		for id := range m.removedplayers {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GameMutation) ClearedEdges() []string {
+func (m *DeckMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedplayers {
-		edges = append(edges, game.EdgePlayers)
+	if m.clearedowner {
+		edges = append(edges, deck.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GameMutation) EdgeCleared(name string) bool {
+func (m *DeckMutation) EdgeCleared(name string) bool {
 	switch name {
-	case game.EdgePlayers:
-		return m.clearedplayers
+	case deck.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GameMutation) ClearEdge(name string) error {
+func (m *DeckMutation) ClearEdge(name string) error {
 	switch name {
+	case deck.EdgeOwner:
+		m.ClearOwner()
+		return nil
 	}
-	return fmt.Errorf("unknown Game unique edge %s", name)
+	return fmt.Errorf("unknown Deck unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GameMutation) ResetEdge(name string) error {
+func (m *DeckMutation) ResetEdge(name string) error {
 	switch name {
-	case game.EdgePlayers:
-		m.ResetPlayers()
+	case deck.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Game edge %s", name)
+	return fmt.Errorf("unknown Deck edge %s", name)
 }
 
-// PlayerMutation represents an operation that mutates the Player nodes in the graph.
-type PlayerMutation struct {
+// GameMutation represents an operation that mutates the Game nodes in the graph.
+type GameMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	_type         *int
-	add_type      *int
-	name          *string
-	clearedFields map[string]struct{}
-	game          *int
-	clearedgame   bool
-	done          bool
-	oldValue      func(context.Context) (*Player, error)
-	predicates    []predicate.Player
+	op                  Op
+	typ                 string
+	id                  *int
+	events              *[]string
+	winner              *int
+	addwinner           *int
+	duration            *int
+	addduration         *int
+	catalog_version     *string
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	players             map[int]struct{}
+	removedplayers      map[int]struct{}
+	clearedplayers      bool
+	participants        map[int]struct{}
+	removedparticipants map[int]struct{}
+	clearedparticipants bool
+	done                bool
+	oldValue            func(context.Context) (*Game, error)
+	predicates          []predicate.Game
 }
 
-var _ ent.Mutation = (*PlayerMutation)(nil)
+var _ ent.Mutation = (*GameMutation)(nil)
 
-// playerOption allows management of the mutation configuration using functional options.
+// gameOption allows management of the mutation configuration using functional options.
+type gameOption func(*GameMutation)
+
+// newGameMutation creates new mutation for the Game entity.
+func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
+	m := &GameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGame,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameID sets the ID field of the mutation.
+func withGameID(id int) gameOption {
+	return func(m *GameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Game
+		)
+		m.oldValue = func(ctx context.Context) (*Game, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Game.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGame sets the old Game of the mutation.
+func withGame(node *Game) gameOption {
+	return func(m *GameMutation) {
+		m.oldValue = func(context.Context) (*Game, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Game entities.
+func (m *GameMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *GameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetEvents sets the "events" field.
+func (m *GameMutation) SetEvents(s []string) {
+	m.events = &s
+}
+
+// Events returns the value of the "events" field in the mutation.
+func (m *GameMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEvents returns the old "events" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldEvents(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldEvents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldEvents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvents: %w", err)
+	}
+	return oldValue.Events, nil
+}
+
+// ClearEvents clears the value of the "events" field.
+func (m *GameMutation) ClearEvents() {
+	m.events = nil
+	m.clearedFields[game.FieldEvents] = struct{}{}
+}
+
+// EventsCleared returns if the "events" field was cleared in this mutation.
+func (m *GameMutation) EventsCleared() bool {
+	_, ok := m.clearedFields[game.FieldEvents]
+	return ok
+}
+
+// ResetEvents resets all changes to the "events" field.
+func (m *GameMutation) ResetEvents() {
+	m.events = nil
+	delete(m.clearedFields, game.FieldEvents)
+}
+
+// SetWinner sets the "winner" field.
+func (m *GameMutation) SetWinner(i int) {
+	m.winner = &i
+	m.addwinner = nil
+}
+
+// Winner returns the value of the "winner" field in the mutation.
+func (m *GameMutation) Winner() (r int, exists bool) {
+	v := m.winner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWinner returns the old "winner" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldWinner(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldWinner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldWinner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinner: %w", err)
+	}
+	return oldValue.Winner, nil
+}
+
+// AddWinner adds i to the "winner" field.
+func (m *GameMutation) AddWinner(i int) {
+	if m.addwinner != nil {
+		*m.addwinner += i
+	} else {
+		m.addwinner = &i
+	}
+}
+
+// AddedWinner returns the value that was added to the "winner" field in this mutation.
+func (m *GameMutation) AddedWinner() (r int, exists bool) {
+	v := m.addwinner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWinner resets all changes to the "winner" field.
+func (m *GameMutation) ResetWinner() {
+	m.winner = nil
+	m.addwinner = nil
+}
+
+// SetDuration sets the "duration" field.
+func (m *GameMutation) SetDuration(i int) {
+	m.duration = &i
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *GameMutation) Duration() (r int, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldDuration(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds i to the "duration" field.
+func (m *GameMutation) AddDuration(i int) {
+	if m.addduration != nil {
+		*m.addduration += i
+	} else {
+		m.addduration = &i
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *GameMutation) AddedDuration() (r int, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *GameMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+}
+
+// SetCatalogVersion sets the "catalog_version" field.
+func (m *GameMutation) SetCatalogVersion(s string) {
+	m.catalog_version = &s
+}
+
+// CatalogVersion returns the value of the "catalog_version" field in the mutation.
+func (m *GameMutation) CatalogVersion() (r string, exists bool) {
+	v := m.catalog_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCatalogVersion returns the old "catalog_version" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldCatalogVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCatalogVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCatalogVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatalogVersion: %w", err)
+	}
+	return oldValue.CatalogVersion, nil
+}
+
+// ClearCatalogVersion clears the value of the "catalog_version" field.
+func (m *GameMutation) ClearCatalogVersion() {
+	m.catalog_version = nil
+	m.clearedFields[game.FieldCatalogVersion] = struct{}{}
+}
+
+// CatalogVersionCleared returns if the "catalog_version" field was cleared in this mutation.
+func (m *GameMutation) CatalogVersionCleared() bool {
+	_, ok := m.clearedFields[game.FieldCatalogVersion]
+	return ok
+}
+
+// ResetCatalogVersion resets all changes to the "catalog_version" field.
+func (m *GameMutation) ResetCatalogVersion() {
+	m.catalog_version = nil
+	delete(m.clearedFields, game.FieldCatalogVersion)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GameMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GameMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GameMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by ids.
+func (m *GameMutation) AddPlayerIDs(ids ...int) {
+	if m.players == nil {
+		m.players = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.players[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayers clears the "players" edge to the Player entity.
+func (m *GameMutation) ClearPlayers() {
+	m.clearedplayers = true
+}
+
+// PlayersCleared returns if the "players" edge to the Player entity was cleared.
+func (m *GameMutation) PlayersCleared() bool {
+	return m.clearedplayers
+}
+
+// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
+func (m *GameMutation) RemovePlayerIDs(ids ...int) {
+	if m.removedplayers == nil {
+		m.removedplayers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedplayers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
+func (m *GameMutation) RemovedPlayersIDs() (ids []int) {
+	for id := range m.removedplayers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlayersIDs returns the "players" edge IDs in the mutation.
+func (m *GameMutation) PlayersIDs() (ids []int) {
+	for id := range m.players {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlayers resets all changes to the "players" edge.
+func (m *GameMutation) ResetPlayers() {
+	m.players = nil
+	m.clearedplayers = false
+	m.removedplayers = nil
+}
+
+// AddParticipantIDs adds the "participants" edge to the Participant entity by ids.
+func (m *GameMutation) AddParticipantIDs(ids ...int) {
+	if m.participants == nil {
+		m.participants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.participants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParticipants clears the "participants" edge to the Participant entity.
+func (m *GameMutation) ClearParticipants() {
+	m.clearedparticipants = true
+}
+
+// ParticipantsCleared returns if the "participants" edge to the Participant entity was cleared.
+func (m *GameMutation) ParticipantsCleared() bool {
+	return m.clearedparticipants
+}
+
+// RemoveParticipantIDs removes the "participants" edge to the Participant entity by IDs.
+func (m *GameMutation) RemoveParticipantIDs(ids ...int) {
+	if m.removedparticipants == nil {
+		m.removedparticipants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedparticipants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipants returns the removed IDs of the "participants" edge to the Participant entity.
+func (m *GameMutation) RemovedParticipantsIDs() (ids []int) {
+	for id := range m.removedparticipants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParticipantsIDs returns the "participants" edge IDs in the mutation.
+func (m *GameMutation) ParticipantsIDs() (ids []int) {
+	for id := range m.participants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParticipants resets all changes to the "participants" edge.
+func (m *GameMutation) ResetParticipants() {
+	m.participants = nil
+	m.clearedparticipants = false
+	m.removedparticipants = nil
+}
+
+// Op returns the operation name.
+func (m *GameMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Game).
+func (m *GameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.events != nil {
+		fields = append(fields, game.FieldEvents)
+	}
+	if m.winner != nil {
+		fields = append(fields, game.FieldWinner)
+	}
+	if m.duration != nil {
+		fields = append(fields, game.FieldDuration)
+	}
+	if m.catalog_version != nil {
+		fields = append(fields, game.FieldCatalogVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, game.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldEvents:
+		return m.Events()
+	case game.FieldWinner:
+		return m.Winner()
+	case game.FieldDuration:
+		return m.Duration()
+	case game.FieldCatalogVersion:
+		return m.CatalogVersion()
+	case game.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case game.FieldEvents:
+		return m.OldEvents(ctx)
+	case game.FieldWinner:
+		return m.OldWinner(ctx)
+	case game.FieldDuration:
+		return m.OldDuration(ctx)
+	case game.FieldCatalogVersion:
+		return m.OldCatalogVersion(ctx)
+	case game.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Game field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case game.FieldWinner:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinner(v)
+		return nil
+	case game.FieldDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case game.FieldCatalogVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatalogVersion(v)
+		return nil
+	case game.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameMutation) AddedFields() []string {
+	var fields []string
+	if m.addwinner != nil {
+		fields = append(fields, game.FieldWinner)
+	}
+	if m.addduration != nil {
+		fields = append(fields, game.FieldDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldWinner:
+		return m.AddedWinner()
+	case game.FieldDuration:
+		return m.AddedDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldWinner:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWinner(v)
+		return nil
+	case game.FieldDuration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(game.FieldEvents) {
+		fields = append(fields, game.FieldEvents)
+	}
+	if m.FieldCleared(game.FieldCatalogVersion) {
+		fields = append(fields, game.FieldCatalogVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameMutation) ClearField(name string) error {
+	switch name {
+	case game.FieldEvents:
+		m.ClearEvents()
+		return nil
+	case game.FieldCatalogVersion:
+		m.ClearCatalogVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown Game nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameMutation) ResetField(name string) error {
+	switch name {
+	case game.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case game.FieldWinner:
+		m.ResetWinner()
+		return nil
+	case game.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case game.FieldCatalogVersion:
+		m.ResetCatalogVersion()
+		return nil
+	case game.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.players != nil {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.participants != nil {
+		edges = append(edges, game.EdgeParticipants)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.players))
+		for id := range m.players {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.participants))
+		for id := range m.participants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedplayers != nil {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.removedparticipants != nil {
+		edges = append(edges, game.EdgeParticipants)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.removedplayers))
+		for id := range m.removedplayers {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeParticipants:
+		ids := make([]ent.Value, 0, len(m.removedparticipants))
+		for id := range m.removedparticipants {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedplayers {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.clearedparticipants {
+		edges = append(edges, game.EdgeParticipants)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case game.EdgePlayers:
+		return m.clearedplayers
+	case game.EdgeParticipants:
+		return m.clearedparticipants
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Game unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameMutation) ResetEdge(name string) error {
+	switch name {
+	case game.EdgePlayers:
+		m.ResetPlayers()
+		return nil
+	case game.EdgeParticipants:
+		m.ResetParticipants()
+		return nil
+	}
+	return fmt.Errorf("unknown Game edge %s", name)
+}
+
+// OwnedCardMutation represents an operation that mutates the OwnedCard nodes in the graph.
+type OwnedCardMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	card          *int
+	addcard       *int
+	source        *ownedcard.Source
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *int
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*OwnedCard, error)
+	predicates    []predicate.OwnedCard
+}
+
+var _ ent.Mutation = (*OwnedCardMutation)(nil)
+
+// ownedcardOption allows management of the mutation configuration using functional options.
+type ownedcardOption func(*OwnedCardMutation)
+
+// newOwnedCardMutation creates new mutation for the OwnedCard entity.
+func newOwnedCardMutation(c config, op Op, opts ...ownedcardOption) *OwnedCardMutation {
+	m := &OwnedCardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOwnedCard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOwnedCardID sets the ID field of the mutation.
+func withOwnedCardID(id int) ownedcardOption {
+	return func(m *OwnedCardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OwnedCard
+		)
+		m.oldValue = func(ctx context.Context) (*OwnedCard, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OwnedCard.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOwnedCard sets the old OwnedCard of the mutation.
+func withOwnedCard(node *OwnedCard) ownedcardOption {
+	return func(m *OwnedCardMutation) {
+		m.oldValue = func(context.Context) (*OwnedCard, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OwnedCardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OwnedCardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *OwnedCardMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetCard sets the "card" field.
+func (m *OwnedCardMutation) SetCard(i int) {
+	m.card = &i
+	m.addcard = nil
+}
+
+// Card returns the value of the "card" field in the mutation.
+func (m *OwnedCardMutation) Card() (r int, exists bool) {
+	v := m.card
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCard returns the old "card" field's value of the OwnedCard entity.
+// If the OwnedCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnedCardMutation) OldCard(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCard is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCard requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCard: %w", err)
+	}
+	return oldValue.Card, nil
+}
+
+// AddCard adds i to the "card" field.
+func (m *OwnedCardMutation) AddCard(i int) {
+	if m.addcard != nil {
+		*m.addcard += i
+	} else {
+		m.addcard = &i
+	}
+}
+
+// AddedCard returns the value that was added to the "card" field in this mutation.
+func (m *OwnedCardMutation) AddedCard() (r int, exists bool) {
+	v := m.addcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCard resets all changes to the "card" field.
+func (m *OwnedCardMutation) ResetCard() {
+	m.card = nil
+	m.addcard = nil
+}
+
+// SetSource sets the "source" field.
+func (m *OwnedCardMutation) SetSource(o ownedcard.Source) {
+	m.source = &o
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *OwnedCardMutation) Source() (r ownedcard.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the OwnedCard entity.
+// If the OwnedCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnedCardMutation) OldSource(ctx context.Context) (v ownedcard.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *OwnedCardMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OwnedCardMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OwnedCardMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OwnedCard entity.
+// If the OwnedCard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnedCardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OwnedCardMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by id.
+func (m *OwnedCardMutation) SetOwnerID(id int) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (m *OwnedCardMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared returns if the "owner" edge to the Player entity was cleared.
+func (m *OwnedCardMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *OwnedCardMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *OwnedCardMutation) OwnerIDs() (ids []int) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *OwnedCardMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Op returns the operation name.
+func (m *OwnedCardMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (OwnedCard).
+func (m *OwnedCardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OwnedCardMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.card != nil {
+		fields = append(fields, ownedcard.FieldCard)
+	}
+	if m.source != nil {
+		fields = append(fields, ownedcard.FieldSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ownedcard.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OwnedCardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ownedcard.FieldCard:
+		return m.Card()
+	case ownedcard.FieldSource:
+		return m.Source()
+	case ownedcard.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OwnedCardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ownedcard.FieldCard:
+		return m.OldCard(ctx)
+	case ownedcard.FieldSource:
+		return m.OldSource(ctx)
+	case ownedcard.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OwnedCard field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnedCardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ownedcard.FieldCard:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCard(v)
+		return nil
+	case ownedcard.FieldSource:
+		v, ok := value.(ownedcard.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case ownedcard.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnedCard field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OwnedCardMutation) AddedFields() []string {
+	var fields []string
+	if m.addcard != nil {
+		fields = append(fields, ownedcard.FieldCard)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OwnedCardMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ownedcard.FieldCard:
+		return m.AddedCard()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnedCardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ownedcard.FieldCard:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCard(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnedCard numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OwnedCardMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OwnedCardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OwnedCardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OwnedCard nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OwnedCardMutation) ResetField(name string) error {
+	switch name {
+	case ownedcard.FieldCard:
+		m.ResetCard()
+		return nil
+	case ownedcard.FieldSource:
+		m.ResetSource()
+		return nil
+	case ownedcard.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnedCard field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OwnedCardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, ownedcard.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OwnedCardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ownedcard.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OwnedCardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OwnedCardMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OwnedCardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, ownedcard.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OwnedCardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ownedcard.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OwnedCardMutation) ClearEdge(name string) error {
+	switch name {
+	case ownedcard.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnedCard unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OwnedCardMutation) ResetEdge(name string) error {
+	switch name {
+	case ownedcard.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnedCard edge %s", name)
+}
+
+// ParticipantMutation represents an operation that mutates the Participant nodes in the graph.
+type ParticipantMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	team          *int
+	addteam       *int
+	deck          *[]uint32
+	left          *bool
+	clearedFields map[string]struct{}
+	game          *int
+	clearedgame   bool
+	player        *int
+	clearedplayer bool
+	done          bool
+	oldValue      func(context.Context) (*Participant, error)
+	predicates    []predicate.Participant
+}
+
+var _ ent.Mutation = (*ParticipantMutation)(nil)
+
+// participantOption allows management of the mutation configuration using functional options.
+type participantOption func(*ParticipantMutation)
+
+// newParticipantMutation creates new mutation for the Participant entity.
+func newParticipantMutation(c config, op Op, opts ...participantOption) *ParticipantMutation {
+	m := &ParticipantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeParticipant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withParticipantID sets the ID field of the mutation.
+func withParticipantID(id int) participantOption {
+	return func(m *ParticipantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Participant
+		)
+		m.oldValue = func(ctx context.Context) (*Participant, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Participant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withParticipant sets the old Participant of the mutation.
+func withParticipant(node *Participant) participantOption {
+	return func(m *ParticipantMutation) {
+		m.oldValue = func(context.Context) (*Participant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ParticipantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ParticipantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *ParticipantMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the "name" field.
+func (m *ParticipantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ParticipantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Participant entity.
+// If the Participant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ParticipantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *ParticipantMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[participant.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *ParticipantMutation) NameCleared() bool {
+	_, ok := m.clearedFields[participant.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ParticipantMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, participant.FieldName)
+}
+
+// SetTeam sets the "team" field.
+func (m *ParticipantMutation) SetTeam(i int) {
+	m.team = &i
+	m.addteam = nil
+}
+
+// Team returns the value of the "team" field in the mutation.
+func (m *ParticipantMutation) Team() (r int, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeam returns the old "team" field's value of the Participant entity.
+// If the Participant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ParticipantMutation) OldTeam(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTeam is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTeam requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeam: %w", err)
+	}
+	return oldValue.Team, nil
+}
+
+// AddTeam adds i to the "team" field.
+func (m *ParticipantMutation) AddTeam(i int) {
+	if m.addteam != nil {
+		*m.addteam += i
+	} else {
+		m.addteam = &i
+	}
+}
+
+// AddedTeam returns the value that was added to the "team" field in this mutation.
+func (m *ParticipantMutation) AddedTeam() (r int, exists bool) {
+	v := m.addteam
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTeam resets all changes to the "team" field.
+func (m *ParticipantMutation) ResetTeam() {
+	m.team = nil
+	m.addteam = nil
+}
+
+// SetDeck sets the "deck" field.
+func (m *ParticipantMutation) SetDeck(u []uint32) {
+	m.deck = &u
+}
+
+// Deck returns the value of the "deck" field in the mutation.
+func (m *ParticipantMutation) Deck() (r []uint32, exists bool) {
+	v := m.deck
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeck returns the old "deck" field's value of the Participant entity.
+// If the Participant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ParticipantMutation) OldDeck(ctx context.Context) (v []uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDeck is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDeck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeck: %w", err)
+	}
+	return oldValue.Deck, nil
+}
+
+// ClearDeck clears the value of the "deck" field.
+func (m *ParticipantMutation) ClearDeck() {
+	m.deck = nil
+	m.clearedFields[participant.FieldDeck] = struct{}{}
+}
+
+// DeckCleared returns if the "deck" field was cleared in this mutation.
+func (m *ParticipantMutation) DeckCleared() bool {
+	_, ok := m.clearedFields[participant.FieldDeck]
+	return ok
+}
+
+// ResetDeck resets all changes to the "deck" field.
+func (m *ParticipantMutation) ResetDeck() {
+	m.deck = nil
+	delete(m.clearedFields, participant.FieldDeck)
+}
+
+// SetLeft sets the "left" field.
+func (m *ParticipantMutation) SetLeft(b bool) {
+	m.left = &b
+}
+
+// Left returns the value of the "left" field in the mutation.
+func (m *ParticipantMutation) Left() (r bool, exists bool) {
+	v := m.left
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeft returns the old "left" field's value of the Participant entity.
+// If the Participant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ParticipantMutation) OldLeft(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLeft is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLeft requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeft: %w", err)
+	}
+	return oldValue.Left, nil
+}
+
+// ResetLeft resets all changes to the "left" field.
+func (m *ParticipantMutation) ResetLeft() {
+	m.left = nil
+}
+
+// SetGameID sets the "game" edge to the Game entity by id.
+func (m *ParticipantMutation) SetGameID(id int) {
+	m.game = &id
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *ParticipantMutation) ClearGame() {
+	m.clearedgame = true
+}
+
+// GameCleared returns if the "game" edge to the Game entity was cleared.
+func (m *ParticipantMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameID returns the "game" edge ID in the mutation.
+func (m *ParticipantMutation) GameID() (id int, exists bool) {
+	if m.game != nil {
+		return *m.game, true
+	}
+	return
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *ParticipantMutation) GameIDs() (ids []int) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *ParticipantMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *ParticipantMutation) SetPlayerID(id int) {
+	m.player = &id
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *ParticipantMutation) ClearPlayer() {
+	m.clearedplayer = true
+}
+
+// PlayerCleared returns if the "player" edge to the Player entity was cleared.
+func (m *ParticipantMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *ParticipantMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
+	}
+	return
+}
+
+// PlayerIDs returns the "player" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *ParticipantMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPlayer resets all changes to the "player" edge.
+func (m *ParticipantMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
+}
+
+// Op returns the operation name.
+func (m *ParticipantMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Participant).
+func (m *ParticipantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ParticipantMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, participant.FieldName)
+	}
+	if m.team != nil {
+		fields = append(fields, participant.FieldTeam)
+	}
+	if m.deck != nil {
+		fields = append(fields, participant.FieldDeck)
+	}
+	if m.left != nil {
+		fields = append(fields, participant.FieldLeft)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ParticipantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case participant.FieldName:
+		return m.Name()
+	case participant.FieldTeam:
+		return m.Team()
+	case participant.FieldDeck:
+		return m.Deck()
+	case participant.FieldLeft:
+		return m.Left()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ParticipantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case participant.FieldName:
+		return m.OldName(ctx)
+	case participant.FieldTeam:
+		return m.OldTeam(ctx)
+	case participant.FieldDeck:
+		return m.OldDeck(ctx)
+	case participant.FieldLeft:
+		return m.OldLeft(ctx)
+	}
+	return nil, fmt.Errorf("unknown Participant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ParticipantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case participant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case participant.FieldTeam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeam(v)
+		return nil
+	case participant.FieldDeck:
+		v, ok := value.([]uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeck(v)
+		return nil
+	case participant.FieldLeft:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeft(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Participant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ParticipantMutation) AddedFields() []string {
+	var fields []string
+	if m.addteam != nil {
+		fields = append(fields, participant.FieldTeam)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ParticipantMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case participant.FieldTeam:
+		return m.AddedTeam()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ParticipantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case participant.FieldTeam:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTeam(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Participant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ParticipantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(participant.FieldName) {
+		fields = append(fields, participant.FieldName)
+	}
+	if m.FieldCleared(participant.FieldDeck) {
+		fields = append(fields, participant.FieldDeck)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ParticipantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ParticipantMutation) ClearField(name string) error {
+	switch name {
+	case participant.FieldName:
+		m.ClearName()
+		return nil
+	case participant.FieldDeck:
+		m.ClearDeck()
+		return nil
+	}
+	return fmt.Errorf("unknown Participant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ParticipantMutation) ResetField(name string) error {
+	switch name {
+	case participant.FieldName:
+		m.ResetName()
+		return nil
+	case participant.FieldTeam:
+		m.ResetTeam()
+		return nil
+	case participant.FieldDeck:
+		m.ResetDeck()
+		return nil
+	case participant.FieldLeft:
+		m.ResetLeft()
+		return nil
+	}
+	return fmt.Errorf("unknown Participant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ParticipantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.game != nil {
+		edges = append(edges, participant.EdgeGame)
+	}
+	if m.player != nil {
+		edges = append(edges, participant.EdgePlayer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ParticipantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case participant.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case participant.EdgePlayer:
+		if id := m.player; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ParticipantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ParticipantMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ParticipantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgame {
+		edges = append(edges, participant.EdgeGame)
+	}
+	if m.clearedplayer {
+		edges = append(edges, participant.EdgePlayer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ParticipantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case participant.EdgeGame:
+		return m.clearedgame
+	case participant.EdgePlayer:
+		return m.clearedplayer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ParticipantMutation) ClearEdge(name string) error {
+	switch name {
+	case participant.EdgeGame:
+		m.ClearGame()
+		return nil
+	case participant.EdgePlayer:
+		m.ClearPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Participant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ParticipantMutation) ResetEdge(name string) error {
+	switch name {
+	case participant.EdgeGame:
+		m.ResetGame()
+		return nil
+	case participant.EdgePlayer:
+		m.ResetPlayer()
+		return nil
+	}
+	return fmt.Errorf("unknown Participant edge %s", name)
+}
+
+// PlayerMutation represents an operation that mutates the Player nodes in the graph.
+type PlayerMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	_type                 *int
+	add_type              *int
+	name                  *string
+	rating                *int
+	addrating             *int
+	defender_rating       *int
+	adddefender_rating    *int
+	attacker_rating       *int
+	addattacker_rating    *int
+	semo_rating           *int
+	addsemo_rating        *int
+	experience            *int
+	addexperience         *int
+	starter_granted       *bool
+	secret                *string
+	clearedFields         map[string]struct{}
+	game                  *int
+	clearedgame           bool
+	ratings               map[int]struct{}
+	removedratings        map[int]struct{}
+	clearedratings        bool
+	participations        map[int]struct{}
+	removedparticipations map[int]struct{}
+	clearedparticipations bool
+	decks                 map[int]struct{}
+	removeddecks          map[int]struct{}
+	cleareddecks          bool
+	cards                 map[int]struct{}
+	removedcards          map[int]struct{}
+	clearedcards          bool
+	done                  bool
+	oldValue              func(context.Context) (*Player, error)
+	predicates            []predicate.Player
+}
+
+var _ ent.Mutation = (*PlayerMutation)(nil)
+
+// playerOption allows management of the mutation configuration using functional options.
 type playerOption func(*PlayerMutation)
 
-// newPlayerMutation creates new mutation for the Player entity.
-func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
-	m := &PlayerMutation{
+// newPlayerMutation creates new mutation for the Player entity.
+func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
+	m := &PlayerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerID sets the ID field of the mutation.
+func withPlayerID(id int) playerOption {
+	return func(m *PlayerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Player
+		)
+		m.oldValue = func(ctx context.Context) (*Player, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Player.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayer sets the old Player of the mutation.
+func withPlayer(node *Player) playerOption {
+	return func(m *PlayerMutation) {
+		m.oldValue = func(context.Context) (*Player, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *PlayerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetType sets the "type" field.
+func (m *PlayerMutation) SetType(i int) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *PlayerMutation) GetType() (r int, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// AddType adds i to the "type" field.
+func (m *PlayerMutation) AddType(i int) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
+	}
+}
+
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *PlayerMutation) AddedType() (r int, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *PlayerMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// SetName sets the "name" field.
+func (m *PlayerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlayerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlayerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *PlayerMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *PlayerMutation) Rating() (r int, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds i to the "rating" field.
+func (m *PlayerMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *PlayerMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *PlayerMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetDefenderRating sets the "defender_rating" field.
+func (m *PlayerMutation) SetDefenderRating(i int) {
+	m.defender_rating = &i
+	m.adddefender_rating = nil
+}
+
+// DefenderRating returns the value of the "defender_rating" field in the mutation.
+func (m *PlayerMutation) DefenderRating() (r int, exists bool) {
+	v := m.defender_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDefenderRating returns the old "defender_rating" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldDefenderRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDefenderRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDefenderRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDefenderRating: %w", err)
+	}
+	return oldValue.DefenderRating, nil
+}
+
+// AddDefenderRating adds i to the "defender_rating" field.
+func (m *PlayerMutation) AddDefenderRating(i int) {
+	if m.adddefender_rating != nil {
+		*m.adddefender_rating += i
+	} else {
+		m.adddefender_rating = &i
+	}
+}
+
+// AddedDefenderRating returns the value that was added to the "defender_rating" field in this mutation.
+func (m *PlayerMutation) AddedDefenderRating() (r int, exists bool) {
+	v := m.adddefender_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDefenderRating resets all changes to the "defender_rating" field.
+func (m *PlayerMutation) ResetDefenderRating() {
+	m.defender_rating = nil
+	m.adddefender_rating = nil
+}
+
+// SetAttackerRating sets the "attacker_rating" field.
+func (m *PlayerMutation) SetAttackerRating(i int) {
+	m.attacker_rating = &i
+	m.addattacker_rating = nil
+}
+
+// AttackerRating returns the value of the "attacker_rating" field in the mutation.
+func (m *PlayerMutation) AttackerRating() (r int, exists bool) {
+	v := m.attacker_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttackerRating returns the old "attacker_rating" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldAttackerRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAttackerRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAttackerRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttackerRating: %w", err)
+	}
+	return oldValue.AttackerRating, nil
+}
+
+// AddAttackerRating adds i to the "attacker_rating" field.
+func (m *PlayerMutation) AddAttackerRating(i int) {
+	if m.addattacker_rating != nil {
+		*m.addattacker_rating += i
+	} else {
+		m.addattacker_rating = &i
+	}
+}
+
+// AddedAttackerRating returns the value that was added to the "attacker_rating" field in this mutation.
+func (m *PlayerMutation) AddedAttackerRating() (r int, exists bool) {
+	v := m.addattacker_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttackerRating resets all changes to the "attacker_rating" field.
+func (m *PlayerMutation) ResetAttackerRating() {
+	m.attacker_rating = nil
+	m.addattacker_rating = nil
+}
+
+// SetSemoRating sets the "semo_rating" field.
+func (m *PlayerMutation) SetSemoRating(i int) {
+	m.semo_rating = &i
+	m.addsemo_rating = nil
+}
+
+// SemoRating returns the value of the "semo_rating" field in the mutation.
+func (m *PlayerMutation) SemoRating() (r int, exists bool) {
+	v := m.semo_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSemoRating returns the old "semo_rating" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldSemoRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSemoRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSemoRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSemoRating: %w", err)
+	}
+	return oldValue.SemoRating, nil
+}
+
+// AddSemoRating adds i to the "semo_rating" field.
+func (m *PlayerMutation) AddSemoRating(i int) {
+	if m.addsemo_rating != nil {
+		*m.addsemo_rating += i
+	} else {
+		m.addsemo_rating = &i
+	}
+}
+
+// AddedSemoRating returns the value that was added to the "semo_rating" field in this mutation.
+func (m *PlayerMutation) AddedSemoRating() (r int, exists bool) {
+	v := m.addsemo_rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSemoRating resets all changes to the "semo_rating" field.
+func (m *PlayerMutation) ResetSemoRating() {
+	m.semo_rating = nil
+	m.addsemo_rating = nil
+}
+
+// SetExperience sets the "experience" field.
+func (m *PlayerMutation) SetExperience(i int) {
+	m.experience = &i
+	m.addexperience = nil
+}
+
+// Experience returns the value of the "experience" field in the mutation.
+func (m *PlayerMutation) Experience() (r int, exists bool) {
+	v := m.experience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExperience returns the old "experience" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldExperience(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldExperience is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldExperience requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExperience: %w", err)
+	}
+	return oldValue.Experience, nil
+}
+
+// AddExperience adds i to the "experience" field.
+func (m *PlayerMutation) AddExperience(i int) {
+	if m.addexperience != nil {
+		*m.addexperience += i
+	} else {
+		m.addexperience = &i
+	}
+}
+
+// AddedExperience returns the value that was added to the "experience" field in this mutation.
+func (m *PlayerMutation) AddedExperience() (r int, exists bool) {
+	v := m.addexperience
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExperience resets all changes to the "experience" field.
+func (m *PlayerMutation) ResetExperience() {
+	m.experience = nil
+	m.addexperience = nil
+}
+
+// SetStarterGranted sets the "starter_granted" field.
+func (m *PlayerMutation) SetStarterGranted(b bool) {
+	m.starter_granted = &b
+}
+
+// StarterGranted returns the value of the "starter_granted" field in the mutation.
+func (m *PlayerMutation) StarterGranted() (r bool, exists bool) {
+	v := m.starter_granted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStarterGranted returns the old "starter_granted" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldStarterGranted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStarterGranted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStarterGranted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarterGranted: %w", err)
+	}
+	return oldValue.StarterGranted, nil
+}
+
+// ResetStarterGranted resets all changes to the "starter_granted" field.
+func (m *PlayerMutation) ResetStarterGranted() {
+	m.starter_granted = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *PlayerMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *PlayerMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *PlayerMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[player.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *PlayerMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[player.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *PlayerMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, player.FieldSecret)
+}
+
+// SetGameID sets the "game" edge to the Game entity by id.
+func (m *PlayerMutation) SetGameID(id int) {
+	m.game = &id
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *PlayerMutation) ClearGame() {
+	m.clearedgame = true
+}
+
+// GameCleared returns if the "game" edge to the Game entity was cleared.
+func (m *PlayerMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameID returns the "game" edge ID in the mutation.
+func (m *PlayerMutation) GameID() (id int, exists bool) {
+	if m.game != nil {
+		return *m.game, true
+	}
+	return
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) GameIDs() (ids []int) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGame resets all changes to the "game" edge.
+func (m *PlayerMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
+}
+
+// AddRatingIDs adds the "ratings" edge to the RatingChange entity by ids.
+func (m *PlayerMutation) AddRatingIDs(ids ...int) {
+	if m.ratings == nil {
+		m.ratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.ratings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRatings clears the "ratings" edge to the RatingChange entity.
+func (m *PlayerMutation) ClearRatings() {
+	m.clearedratings = true
+}
+
+// RatingsCleared returns if the "ratings" edge to the RatingChange entity was cleared.
+func (m *PlayerMutation) RatingsCleared() bool {
+	return m.clearedratings
+}
+
+// RemoveRatingIDs removes the "ratings" edge to the RatingChange entity by IDs.
+func (m *PlayerMutation) RemoveRatingIDs(ids ...int) {
+	if m.removedratings == nil {
+		m.removedratings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedratings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRatings returns the removed IDs of the "ratings" edge to the RatingChange entity.
+func (m *PlayerMutation) RemovedRatingsIDs() (ids []int) {
+	for id := range m.removedratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RatingsIDs returns the "ratings" edge IDs in the mutation.
+func (m *PlayerMutation) RatingsIDs() (ids []int) {
+	for id := range m.ratings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRatings resets all changes to the "ratings" edge.
+func (m *PlayerMutation) ResetRatings() {
+	m.ratings = nil
+	m.clearedratings = false
+	m.removedratings = nil
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participant entity by ids.
+func (m *PlayerMutation) AddParticipationIDs(ids ...int) {
+	if m.participations == nil {
+		m.participations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.participations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParticipations clears the "participations" edge to the Participant entity.
+func (m *PlayerMutation) ClearParticipations() {
+	m.clearedparticipations = true
+}
+
+// ParticipationsCleared returns if the "participations" edge to the Participant entity was cleared.
+func (m *PlayerMutation) ParticipationsCleared() bool {
+	return m.clearedparticipations
+}
+
+// RemoveParticipationIDs removes the "participations" edge to the Participant entity by IDs.
+func (m *PlayerMutation) RemoveParticipationIDs(ids ...int) {
+	if m.removedparticipations == nil {
+		m.removedparticipations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedparticipations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParticipations returns the removed IDs of the "participations" edge to the Participant entity.
+func (m *PlayerMutation) RemovedParticipationsIDs() (ids []int) {
+	for id := range m.removedparticipations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParticipationsIDs returns the "participations" edge IDs in the mutation.
+func (m *PlayerMutation) ParticipationsIDs() (ids []int) {
+	for id := range m.participations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParticipations resets all changes to the "participations" edge.
+func (m *PlayerMutation) ResetParticipations() {
+	m.participations = nil
+	m.clearedparticipations = false
+	m.removedparticipations = nil
+}
+
+// AddDeckIDs adds the "decks" edge to the Deck entity by ids.
+func (m *PlayerMutation) AddDeckIDs(ids ...int) {
+	if m.decks == nil {
+		m.decks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.decks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDecks clears the "decks" edge to the Deck entity.
+func (m *PlayerMutation) ClearDecks() {
+	m.cleareddecks = true
+}
+
+// DecksCleared returns if the "decks" edge to the Deck entity was cleared.
+func (m *PlayerMutation) DecksCleared() bool {
+	return m.cleareddecks
+}
+
+// RemoveDeckIDs removes the "decks" edge to the Deck entity by IDs.
+func (m *PlayerMutation) RemoveDeckIDs(ids ...int) {
+	if m.removeddecks == nil {
+		m.removeddecks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removeddecks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDecks returns the removed IDs of the "decks" edge to the Deck entity.
+func (m *PlayerMutation) RemovedDecksIDs() (ids []int) {
+	for id := range m.removeddecks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DecksIDs returns the "decks" edge IDs in the mutation.
+func (m *PlayerMutation) DecksIDs() (ids []int) {
+	for id := range m.decks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDecks resets all changes to the "decks" edge.
+func (m *PlayerMutation) ResetDecks() {
+	m.decks = nil
+	m.cleareddecks = false
+	m.removeddecks = nil
+}
+
+// AddCardIDs adds the "cards" edge to the OwnedCard entity by ids.
+func (m *PlayerMutation) AddCardIDs(ids ...int) {
+	if m.cards == nil {
+		m.cards = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.cards[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCards clears the "cards" edge to the OwnedCard entity.
+func (m *PlayerMutation) ClearCards() {
+	m.clearedcards = true
+}
+
+// CardsCleared returns if the "cards" edge to the OwnedCard entity was cleared.
+func (m *PlayerMutation) CardsCleared() bool {
+	return m.clearedcards
+}
+
+// RemoveCardIDs removes the "cards" edge to the OwnedCard entity by IDs.
+func (m *PlayerMutation) RemoveCardIDs(ids ...int) {
+	if m.removedcards == nil {
+		m.removedcards = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedcards[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCards returns the removed IDs of the "cards" edge to the OwnedCard entity.
+func (m *PlayerMutation) RemovedCardsIDs() (ids []int) {
+	for id := range m.removedcards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CardsIDs returns the "cards" edge IDs in the mutation.
+func (m *PlayerMutation) CardsIDs() (ids []int) {
+	for id := range m.cards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCards resets all changes to the "cards" edge.
+func (m *PlayerMutation) ResetCards() {
+	m.cards = nil
+	m.clearedcards = false
+	m.removedcards = nil
+}
+
+// Op returns the operation name.
+func (m *PlayerMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Player).
+func (m *PlayerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m._type != nil {
+		fields = append(fields, player.FieldType)
+	}
+	if m.name != nil {
+		fields = append(fields, player.FieldName)
+	}
+	if m.rating != nil {
+		fields = append(fields, player.FieldRating)
+	}
+	if m.defender_rating != nil {
+		fields = append(fields, player.FieldDefenderRating)
+	}
+	if m.attacker_rating != nil {
+		fields = append(fields, player.FieldAttackerRating)
+	}
+	if m.semo_rating != nil {
+		fields = append(fields, player.FieldSemoRating)
+	}
+	if m.experience != nil {
+		fields = append(fields, player.FieldExperience)
+	}
+	if m.starter_granted != nil {
+		fields = append(fields, player.FieldStarterGranted)
+	}
+	if m.secret != nil {
+		fields = append(fields, player.FieldSecret)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldType:
+		return m.GetType()
+	case player.FieldName:
+		return m.Name()
+	case player.FieldRating:
+		return m.Rating()
+	case player.FieldDefenderRating:
+		return m.DefenderRating()
+	case player.FieldAttackerRating:
+		return m.AttackerRating()
+	case player.FieldSemoRating:
+		return m.SemoRating()
+	case player.FieldExperience:
+		return m.Experience()
+	case player.FieldStarterGranted:
+		return m.StarterGranted()
+	case player.FieldSecret:
+		return m.Secret()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case player.FieldType:
+		return m.OldType(ctx)
+	case player.FieldName:
+		return m.OldName(ctx)
+	case player.FieldRating:
+		return m.OldRating(ctx)
+	case player.FieldDefenderRating:
+		return m.OldDefenderRating(ctx)
+	case player.FieldAttackerRating:
+		return m.OldAttackerRating(ctx)
+	case player.FieldSemoRating:
+		return m.OldSemoRating(ctx)
+	case player.FieldExperience:
+		return m.OldExperience(ctx)
+	case player.FieldStarterGranted:
+		return m.OldStarterGranted(ctx)
+	case player.FieldSecret:
+		return m.OldSecret(ctx)
+	}
+	return nil, fmt.Errorf("unknown Player field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case player.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case player.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case player.FieldDefenderRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDefenderRating(v)
+		return nil
+	case player.FieldAttackerRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttackerRating(v)
+		return nil
+	case player.FieldSemoRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSemoRating(v)
+		return nil
+	case player.FieldExperience:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExperience(v)
+		return nil
+	case player.FieldStarterGranted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarterGranted(v)
+		return nil
+	case player.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerMutation) AddedFields() []string {
+	var fields []string
+	if m.add_type != nil {
+		fields = append(fields, player.FieldType)
+	}
+	if m.addrating != nil {
+		fields = append(fields, player.FieldRating)
+	}
+	if m.adddefender_rating != nil {
+		fields = append(fields, player.FieldDefenderRating)
+	}
+	if m.addattacker_rating != nil {
+		fields = append(fields, player.FieldAttackerRating)
+	}
+	if m.addsemo_rating != nil {
+		fields = append(fields, player.FieldSemoRating)
+	}
+	if m.addexperience != nil {
+		fields = append(fields, player.FieldExperience)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldType:
+		return m.AddedType()
+	case player.FieldRating:
+		return m.AddedRating()
+	case player.FieldDefenderRating:
+		return m.AddedDefenderRating()
+	case player.FieldAttackerRating:
+		return m.AddedAttackerRating()
+	case player.FieldSemoRating:
+		return m.AddedSemoRating()
+	case player.FieldExperience:
+		return m.AddedExperience()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	case player.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case player.FieldDefenderRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDefenderRating(v)
+		return nil
+	case player.FieldAttackerRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttackerRating(v)
+		return nil
+	case player.FieldSemoRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSemoRating(v)
+		return nil
+	case player.FieldExperience:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExperience(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(player.FieldSecret) {
+		fields = append(fields, player.FieldSecret)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerMutation) ClearField(name string) error {
+	switch name {
+	case player.FieldSecret:
+		m.ClearSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown Player nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerMutation) ResetField(name string) error {
+	switch name {
+	case player.FieldType:
+		m.ResetType()
+		return nil
+	case player.FieldName:
+		m.ResetName()
+		return nil
+	case player.FieldRating:
+		m.ResetRating()
+		return nil
+	case player.FieldDefenderRating:
+		m.ResetDefenderRating()
+		return nil
+	case player.FieldAttackerRating:
+		m.ResetAttackerRating()
+		return nil
+	case player.FieldSemoRating:
+		m.ResetSemoRating()
+		return nil
+	case player.FieldExperience:
+		m.ResetExperience()
+		return nil
+	case player.FieldStarterGranted:
+		m.ResetStarterGranted()
+		return nil
+	case player.FieldSecret:
+		m.ResetSecret()
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.game != nil {
+		edges = append(edges, player.EdgeGame)
+	}
+	if m.ratings != nil {
+		edges = append(edges, player.EdgeRatings)
+	}
+	if m.participations != nil {
+		edges = append(edges, player.EdgeParticipations)
+	}
+	if m.decks != nil {
+		edges = append(edges, player.EdgeDecks)
+	}
+	if m.cards != nil {
+		edges = append(edges, player.EdgeCards)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case player.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.ratings))
+		for id := range m.ratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeParticipations:
+		ids := make([]ent.Value, 0, len(m.participations))
+		for id := range m.participations {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeDecks:
+		ids := make([]ent.Value, 0, len(m.decks))
+		for id := range m.decks {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeCards:
+		ids := make([]ent.Value, 0, len(m.cards))
+		for id := range m.cards {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedratings != nil {
+		edges = append(edges, player.EdgeRatings)
+	}
+	if m.removedparticipations != nil {
+		edges = append(edges, player.EdgeParticipations)
+	}
+	if m.removeddecks != nil {
+		edges = append(edges, player.EdgeDecks)
+	}
+	if m.removedcards != nil {
+		edges = append(edges, player.EdgeCards)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeRatings:
+		ids := make([]ent.Value, 0, len(m.removedratings))
+		for id := range m.removedratings {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeParticipations:
+		ids := make([]ent.Value, 0, len(m.removedparticipations))
+		for id := range m.removedparticipations {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeDecks:
+		ids := make([]ent.Value, 0, len(m.removeddecks))
+		for id := range m.removeddecks {
+			ids = append(ids, id)
+		}
+		return ids
+	case player.EdgeCards:
+		ids := make([]ent.Value, 0, len(m.removedcards))
+		for id := range m.removedcards {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedgame {
+		edges = append(edges, player.EdgeGame)
+	}
+	if m.clearedratings {
+		edges = append(edges, player.EdgeRatings)
+	}
+	if m.clearedparticipations {
+		edges = append(edges, player.EdgeParticipations)
+	}
+	if m.cleareddecks {
+		edges = append(edges, player.EdgeDecks)
+	}
+	if m.clearedcards {
+		edges = append(edges, player.EdgeCards)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case player.EdgeGame:
+		return m.clearedgame
+	case player.EdgeRatings:
+		return m.clearedratings
+	case player.EdgeParticipations:
+		return m.clearedparticipations
+	case player.EdgeDecks:
+		return m.cleareddecks
+	case player.EdgeCards:
+		return m.clearedcards
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerMutation) ClearEdge(name string) error {
+	switch name {
+	case player.EdgeGame:
+		m.ClearGame()
+		return nil
+	}
+	return fmt.Errorf("unknown Player unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerMutation) ResetEdge(name string) error {
+	switch name {
+	case player.EdgeGame:
+		m.ResetGame()
+		return nil
+	case player.EdgeRatings:
+		m.ResetRatings()
+		return nil
+	case player.EdgeParticipations:
+		m.ResetParticipations()
+		return nil
+	case player.EdgeDecks:
+		m.ResetDecks()
+		return nil
+	case player.EdgeCards:
+		m.ResetCards()
+		return nil
+	}
+	return fmt.Errorf("unknown Player edge %s", name)
+}
+
+// RatingChangeMutation represents an operation that mutates the RatingChange nodes in the graph.
+type RatingChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	role          *int
+	addrole       *int
+	before        *int
+	addbefore     *int
+	after         *int
+	addafter      *int
+	won           *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	player        *int
+	clearedplayer bool
+	done          bool
+	oldValue      func(context.Context) (*RatingChange, error)
+	predicates    []predicate.RatingChange
+}
+
+var _ ent.Mutation = (*RatingChangeMutation)(nil)
+
+// ratingchangeOption allows management of the mutation configuration using functional options.
+type ratingchangeOption func(*RatingChangeMutation)
+
+// newRatingChangeMutation creates new mutation for the RatingChange entity.
+func newRatingChangeMutation(c config, op Op, opts ...ratingchangeOption) *RatingChangeMutation {
+	m := &RatingChangeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlayer,
+		typ:           TypeRatingChange,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -524,20 +3880,20 @@ func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
 	return m
 }
 
-// withPlayerID sets the ID field of the mutation.
-func withPlayerID(id int) playerOption {
-	return func(m *PlayerMutation) {
+// withRatingChangeID sets the ID field of the mutation.
+func withRatingChangeID(id int) ratingchangeOption {
+	return func(m *RatingChangeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Player
+			value *RatingChange
 		)
-		m.oldValue = func(ctx context.Context) (*Player, error) {
+		m.oldValue = func(ctx context.Context) (*RatingChange, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Player.Get(ctx, id)
+					value, err = m.Client().RatingChange.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -546,195 +3902,352 @@ func withPlayerID(id int) playerOption {
 	}
 }
 
-// withPlayer sets the old Player of the mutation.
-func withPlayer(node *Player) playerOption {
-	return func(m *PlayerMutation) {
-		m.oldValue = func(context.Context) (*Player, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// withRatingChange sets the old RatingChange of the mutation.
+func withRatingChange(node *RatingChange) ratingchangeOption {
+	return func(m *RatingChangeMutation) {
+		m.oldValue = func(context.Context) (*RatingChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RatingChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RatingChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID
+// is only available if it was provided to the builder.
+func (m *RatingChangeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetRole sets the "role" field.
+func (m *RatingChangeMutation) SetRole(i int) {
+	m.role = &i
+	m.addrole = nil
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *RatingChangeMutation) Role() (r int, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the RatingChange entity.
+// If the RatingChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingChangeMutation) OldRole(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// AddRole adds i to the "role" field.
+func (m *RatingChangeMutation) AddRole(i int) {
+	if m.addrole != nil {
+		*m.addrole += i
+	} else {
+		m.addrole = &i
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlayerMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddedRole returns the value that was added to the "role" field in this mutation.
+func (m *RatingChangeMutation) AddedRole() (r int, exists bool) {
+	v := m.addrole
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PlayerMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+// ResetRole resets all changes to the "role" field.
+func (m *RatingChangeMutation) ResetRole() {
+	m.role = nil
+	m.addrole = nil
+}
+
+// SetBefore sets the "before" field.
+func (m *RatingChangeMutation) SetBefore(i int) {
+	m.before = &i
+	m.addbefore = nil
+}
+
+// Before returns the value of the "before" field in the mutation.
+func (m *RatingChangeMutation) Before() (r int, exists bool) {
+	v := m.before
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// ID returns the ID value in the mutation. Note that the ID
-// is only available if it was provided to the builder.
-func (m *PlayerMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// OldBefore returns the old "before" field's value of the RatingChange entity.
+// If the RatingChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingChangeMutation) OldBefore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
+	}
+	return oldValue.Before, nil
+}
+
+// AddBefore adds i to the "before" field.
+func (m *RatingChangeMutation) AddBefore(i int) {
+	if m.addbefore != nil {
+		*m.addbefore += i
+	} else {
+		m.addbefore = &i
+	}
+}
+
+// AddedBefore returns the value that was added to the "before" field in this mutation.
+func (m *RatingChangeMutation) AddedBefore() (r int, exists bool) {
+	v := m.addbefore
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// SetType sets the "type" field.
-func (m *PlayerMutation) SetType(i int) {
-	m._type = &i
-	m.add_type = nil
+// ResetBefore resets all changes to the "before" field.
+func (m *RatingChangeMutation) ResetBefore() {
+	m.before = nil
+	m.addbefore = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *PlayerMutation) GetType() (r int, exists bool) {
-	v := m._type
+// SetAfter sets the "after" field.
+func (m *RatingChangeMutation) SetAfter(i int) {
+	m.after = &i
+	m.addafter = nil
+}
+
+// After returns the value of the "after" field in the mutation.
+func (m *RatingChangeMutation) After() (r int, exists bool) {
+	v := m.after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldAfter returns the old "after" field's value of the RatingChange entity.
+// If the RatingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldType(ctx context.Context) (v int, err error) {
+func (m *RatingChangeMutation) OldAfter(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldType is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldType requires an ID field in the mutation")
+		return v, fmt.Errorf("OldAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.After, nil
 }
 
-// AddType adds i to the "type" field.
-func (m *PlayerMutation) AddType(i int) {
-	if m.add_type != nil {
-		*m.add_type += i
+// AddAfter adds i to the "after" field.
+func (m *RatingChangeMutation) AddAfter(i int) {
+	if m.addafter != nil {
+		*m.addafter += i
 	} else {
-		m.add_type = &i
+		m.addafter = &i
 	}
 }
 
-// AddedType returns the value that was added to the "type" field in this mutation.
-func (m *PlayerMutation) AddedType() (r int, exists bool) {
-	v := m.add_type
+// AddedAfter returns the value that was added to the "after" field in this mutation.
+func (m *RatingChangeMutation) AddedAfter() (r int, exists bool) {
+	v := m.addafter
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *PlayerMutation) ResetType() {
-	m._type = nil
-	m.add_type = nil
+// ResetAfter resets all changes to the "after" field.
+func (m *RatingChangeMutation) ResetAfter() {
+	m.after = nil
+	m.addafter = nil
 }
 
-// SetName sets the "name" field.
-func (m *PlayerMutation) SetName(s string) {
-	m.name = &s
+// SetWon sets the "won" field.
+func (m *RatingChangeMutation) SetWon(b bool) {
+	m.won = &b
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PlayerMutation) Name() (r string, exists bool) {
-	v := m.name
+// Won returns the value of the "won" field in the mutation.
+func (m *RatingChangeMutation) Won() (r bool, exists bool) {
+	v := m.won
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldWon returns the old "won" field's value of the RatingChange entity.
+// If the RatingChange object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *RatingChangeMutation) OldWon(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldWon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldWon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldWon: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Won, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PlayerMutation) ResetName() {
-	m.name = nil
+// ResetWon resets all changes to the "won" field.
+func (m *RatingChangeMutation) ResetWon() {
+	m.won = nil
 }
 
-// SetGameID sets the "game" edge to the Game entity by id.
-func (m *PlayerMutation) SetGameID(id int) {
-	m.game = &id
+// SetCreatedAt sets the "created_at" field.
+func (m *RatingChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ClearGame clears the "game" edge to the Game entity.
-func (m *PlayerMutation) ClearGame() {
-	m.clearedgame = true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RatingChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GameCleared returns if the "game" edge to the Game entity was cleared.
-func (m *PlayerMutation) GameCleared() bool {
-	return m.clearedgame
+// OldCreatedAt returns the old "created_at" field's value of the RatingChange entity.
+// If the RatingChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RatingChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// GameID returns the "game" edge ID in the mutation.
-func (m *PlayerMutation) GameID() (id int, exists bool) {
-	if m.game != nil {
-		return *m.game, true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RatingChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by id.
+func (m *RatingChangeMutation) SetPlayerID(id int) {
+	m.player = &id
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (m *RatingChangeMutation) ClearPlayer() {
+	m.clearedplayer = true
+}
+
+// PlayerCleared returns if the "player" edge to the Player entity was cleared.
+func (m *RatingChangeMutation) PlayerCleared() bool {
+	return m.clearedplayer
+}
+
+// PlayerID returns the "player" edge ID in the mutation.
+func (m *RatingChangeMutation) PlayerID() (id int, exists bool) {
+	if m.player != nil {
+		return *m.player, true
 	}
 	return
 }
 
-// GameIDs returns the "game" edge IDs in the mutation.
+// PlayerIDs returns the "player" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GameID instead. It exists only for internal usage by the builders.
-func (m *PlayerMutation) GameIDs() (ids []int) {
-	if id := m.game; id != nil {
+// PlayerID instead. It exists only for internal usage by the builders.
+func (m *RatingChangeMutation) PlayerIDs() (ids []int) {
+	if id := m.player; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetGame resets all changes to the "game" edge.
-func (m *PlayerMutation) ResetGame() {
-	m.game = nil
-	m.clearedgame = false
+// ResetPlayer resets all changes to the "player" edge.
+func (m *RatingChangeMutation) ResetPlayer() {
+	m.player = nil
+	m.clearedplayer = false
 }
 
 // Op returns the operation name.
-func (m *PlayerMutation) Op() Op {
+func (m *RatingChangeMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Player).
-func (m *PlayerMutation) Type() string {
+// Type returns the node type of this mutation (RatingChange).
+func (m *RatingChangeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlayerMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m._type != nil {
-		fields = append(fields, player.FieldType)
+func (m *RatingChangeMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.role != nil {
+		fields = append(fields, ratingchange.FieldRole)
 	}
-	if m.name != nil {
-		fields = append(fields, player.FieldName)
+	if m.before != nil {
+		fields = append(fields, ratingchange.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, ratingchange.FieldAfter)
+	}
+	if m.won != nil {
+		fields = append(fields, ratingchange.FieldWon)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ratingchange.FieldCreatedAt)
 	}
 	return fields
 }
@@ -742,12 +4255,18 @@ func (m *PlayerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+func (m *RatingChangeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldType:
-		return m.GetType()
-	case player.FieldName:
-		return m.Name()
+	case ratingchange.FieldRole:
+		return m.Role()
+	case ratingchange.FieldBefore:
+		return m.Before()
+	case ratingchange.FieldAfter:
+		return m.After()
+	case ratingchange.FieldWon:
+		return m.Won()
+	case ratingchange.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -755,45 +4274,78 @@ func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RatingChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case player.FieldType:
-		return m.OldType(ctx)
-	case player.FieldName:
-		return m.OldName(ctx)
+	case ratingchange.FieldRole:
+		return m.OldRole(ctx)
+	case ratingchange.FieldBefore:
+		return m.OldBefore(ctx)
+	case ratingchange.FieldAfter:
+		return m.OldAfter(ctx)
+	case ratingchange.FieldWon:
+		return m.OldWon(ctx)
+	case ratingchange.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Player field %s", name)
+	return nil, fmt.Errorf("unknown RatingChange field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+func (m *RatingChangeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case player.FieldType:
+	case ratingchange.FieldRole:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetRole(v)
 		return nil
-	case player.FieldName:
-		v, ok := value.(string)
+	case ratingchange.FieldBefore:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetBefore(v)
+		return nil
+	case ratingchange.FieldAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfter(v)
+		return nil
+	case ratingchange.FieldWon:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWon(v)
+		return nil
+	case ratingchange.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown RatingChange field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PlayerMutation) AddedFields() []string {
+func (m *RatingChangeMutation) AddedFields() []string {
 	var fields []string
-	if m.add_type != nil {
-		fields = append(fields, player.FieldType)
+	if m.addrole != nil {
+		fields = append(fields, ratingchange.FieldRole)
+	}
+	if m.addbefore != nil {
+		fields = append(fields, ratingchange.FieldBefore)
+	}
+	if m.addafter != nil {
+		fields = append(fields, ratingchange.FieldAfter)
 	}
 	return fields
 }
@@ -801,10 +4353,14 @@ func (m *PlayerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RatingChangeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldType:
-		return m.AddedType()
+	case ratingchange.FieldRole:
+		return m.AddedRole()
+	case ratingchange.FieldBefore:
+		return m.AddedBefore()
+	case ratingchange.FieldAfter:
+		return m.AddedAfter()
 	}
 	return nil, false
 }
@@ -812,67 +4368,90 @@ func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+func (m *RatingChangeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case player.FieldType:
+	case ratingchange.FieldRole:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddType(v)
+		m.AddRole(v)
+		return nil
+	case ratingchange.FieldBefore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBefore(v)
+		return nil
+	case ratingchange.FieldAfter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAfter(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player numeric field %s", name)
+	return fmt.Errorf("unknown RatingChange numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlayerMutation) ClearedFields() []string {
+func (m *RatingChangeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlayerMutation) FieldCleared(name string) bool {
+func (m *RatingChangeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlayerMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Player nullable field %s", name)
+func (m *RatingChangeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RatingChange nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlayerMutation) ResetField(name string) error {
+func (m *RatingChangeMutation) ResetField(name string) error {
 	switch name {
-	case player.FieldType:
-		m.ResetType()
+	case ratingchange.FieldRole:
+		m.ResetRole()
 		return nil
-	case player.FieldName:
-		m.ResetName()
+	case ratingchange.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case ratingchange.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case ratingchange.FieldWon:
+		m.ResetWon()
+		return nil
+	case ratingchange.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown RatingChange field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlayerMutation) AddedEdges() []string {
+func (m *RatingChangeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.game != nil {
-		edges = append(edges, player.EdgeGame)
+	if m.player != nil {
+		edges = append(edges, ratingchange.EdgePlayer)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+func (m *RatingChangeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case player.EdgeGame:
-		if id := m.game; id != nil {
+	case ratingchange.EdgePlayer:
+		if id := m.player; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -880,56 +4459,56 @@ func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlayerMutation) RemovedEdges() []string {
+func (m *RatingChangeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+func (m *RatingChangeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlayerMutation) ClearedEdges() []string {
+func (m *RatingChangeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedgame {
-		edges = append(edges, player.EdgeGame)
+	if m.clearedplayer {
+		edges = append(edges, ratingchange.EdgePlayer)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlayerMutation) EdgeCleared(name string) bool {
+func (m *RatingChangeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case player.EdgeGame:
-		return m.clearedgame
+	case ratingchange.EdgePlayer:
+		return m.clearedplayer
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlayerMutation) ClearEdge(name string) error {
+func (m *RatingChangeMutation) ClearEdge(name string) error {
 	switch name {
-	case player.EdgeGame:
-		m.ClearGame()
+	case ratingchange.EdgePlayer:
+		m.ClearPlayer()
 		return nil
 	}
-	return fmt.Errorf("unknown Player unique edge %s", name)
+	return fmt.Errorf("unknown RatingChange unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlayerMutation) ResetEdge(name string) error {
+func (m *RatingChangeMutation) ResetEdge(name string) error {
 	switch name {
-	case player.EdgeGame:
-		m.ResetGame()
+	case ratingchange.EdgePlayer:
+		m.ResetPlayer()
 		return nil
 	}
-	return fmt.Errorf("unknown Player edge %s", name)
+	return fmt.Errorf("unknown RatingChange edge %s", name)
 }
@@ -0,0 +1,164 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/ownedcard"
+	"app/ent/player"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OwnedCard is the model entity for the OwnedCard schema.
+type OwnedCard struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Card holds the value of the "card" field.
+	Card int `json:"card,omitempty"`
+	// Source holds the value of the "source" field.
+	Source ownedcard.Source `json:"source,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OwnedCardQuery when eager-loading is set.
+	Edges        OwnedCardEdges `json:"edges"`
+	player_cards *int
+}
+
+// OwnedCardEdges holds the relations/edges for other nodes in the graph.
+type OwnedCardEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *Player `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OwnedCardEdges) OwnerOrErr() (*Player, error) {
+	if e.loadedTypes[0] {
+		if e.Owner == nil {
+			// The edge owner was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: player.Label}
+		}
+		return e.Owner, nil
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OwnedCard) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ownedcard.FieldID, ownedcard.FieldCard:
+			values[i] = &sql.NullInt64{}
+		case ownedcard.FieldSource:
+			values[i] = &sql.NullString{}
+		case ownedcard.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		case ownedcard.ForeignKeys[0]: // player_cards
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type OwnedCard", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OwnedCard fields.
+func (oc *OwnedCard) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ownedcard.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oc.ID = int(value.Int64)
+		case ownedcard.FieldCard:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field card", values[i])
+			} else if value.Valid {
+				oc.Card = int(value.Int64)
+			}
+		case ownedcard.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				oc.Source = ownedcard.Source(value.String)
+			}
+		case ownedcard.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oc.CreatedAt = value.Time
+			}
+		case ownedcard.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_cards", value)
+			} else if value.Valid {
+				oc.player_cards = new(int)
+				*oc.player_cards = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryOwner queries the "owner" edge of the OwnedCard entity.
+func (oc *OwnedCard) QueryOwner() *PlayerQuery {
+	return (&OwnedCardClient{config: oc.config}).QueryOwner(oc)
+}
+
+// Update returns a builder for updating this OwnedCard.
+// Note that you need to call OwnedCard.Unwrap() before calling this method if this OwnedCard
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oc *OwnedCard) Update() *OwnedCardUpdateOne {
+	return (&OwnedCardClient{config: oc.config}).UpdateOne(oc)
+}
+
+// Unwrap unwraps the OwnedCard entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oc *OwnedCard) Unwrap() *OwnedCard {
+	tx, ok := oc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OwnedCard is not a transactional entity")
+	}
+	oc.config.driver = tx.drv
+	return oc
+}
+
+// String implements the fmt.Stringer.
+func (oc *OwnedCard) String() string {
+	var builder strings.Builder
+	builder.WriteString("OwnedCard(")
+	builder.WriteString(fmt.Sprintf("id=%v", oc.ID))
+	builder.WriteString(", card=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Card))
+	builder.WriteString(", source=")
+	builder.WriteString(fmt.Sprintf("%v", oc.Source))
+	builder.WriteString(", created_at=")
+	builder.WriteString(oc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OwnedCards is a parsable slice of OwnedCard.
+type OwnedCards []*OwnedCard
+
+func (oc OwnedCards) config(cfg config) {
+	for _i := range oc {
+		oc[_i].config = cfg
+	}
+}
@@ -0,0 +1,93 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ownedcard
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the ownedcard type in the database.
+	Label = "owned_card"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCard holds the string denoting the card field in the database.
+	FieldCard = "card"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+
+	// Table holds the table name of the ownedcard in the database.
+	Table = "owned_cards"
+	// OwnerTable is the table the holds the owner relation/edge.
+	OwnerTable = "owned_cards"
+	// OwnerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	OwnerInverseTable = "players"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "player_cards"
+)
+
+// Columns holds all SQL columns for ownedcard fields.
+var Columns = []string{
+	FieldID,
+	FieldCard,
+	FieldSource,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the OwnedCard type.
+var ForeignKeys = []string{
+	"player_cards",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CardValidator is a validator for the "card" field. It is called by the builders before save.
+	CardValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Source defines the type for the "source" enum field.
+type Source string
+
+// Source values.
+const (
+	SourceStarter Source = "starter"
+	SourceUnlock  Source = "unlock"
+	SourceAdmin   Source = "admin"
+)
+
+func (s Source) String() string {
+	return string(s)
+}
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s Source) error {
+	switch s {
+	case SourceStarter, SourceUnlock, SourceAdmin:
+		return nil
+	default:
+		return fmt.Errorf("ownedcard: invalid enum value for source field: %q", s)
+	}
+}
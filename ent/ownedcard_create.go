@@ -0,0 +1,281 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/ownedcard"
+	"app/ent/player"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnedCardCreate is the builder for creating a OwnedCard entity.
+type OwnedCardCreate struct {
+	config
+	mutation *OwnedCardMutation
+	hooks    []Hook
+}
+
+// SetCard sets the "card" field.
+func (occ *OwnedCardCreate) SetCard(i int) *OwnedCardCreate {
+	occ.mutation.SetCard(i)
+	return occ
+}
+
+// SetSource sets the "source" field.
+func (occ *OwnedCardCreate) SetSource(o ownedcard.Source) *OwnedCardCreate {
+	occ.mutation.SetSource(o)
+	return occ
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (occ *OwnedCardCreate) SetCreatedAt(t time.Time) *OwnedCardCreate {
+	occ.mutation.SetCreatedAt(t)
+	return occ
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (occ *OwnedCardCreate) SetNillableCreatedAt(t *time.Time) *OwnedCardCreate {
+	if t != nil {
+		occ.SetCreatedAt(*t)
+	}
+	return occ
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by ID.
+func (occ *OwnedCardCreate) SetOwnerID(id int) *OwnedCardCreate {
+	occ.mutation.SetOwnerID(id)
+	return occ
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (occ *OwnedCardCreate) SetOwner(p *Player) *OwnedCardCreate {
+	return occ.SetOwnerID(p.ID)
+}
+
+// Mutation returns the OwnedCardMutation object of the builder.
+func (occ *OwnedCardCreate) Mutation() *OwnedCardMutation {
+	return occ.mutation
+}
+
+// Save creates the OwnedCard in the database.
+func (occ *OwnedCardCreate) Save(ctx context.Context) (*OwnedCard, error) {
+	var (
+		err  error
+		node *OwnedCard
+	)
+	occ.defaults()
+	if len(occ.hooks) == 0 {
+		if err = occ.check(); err != nil {
+			return nil, err
+		}
+		node, err = occ.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnedCardMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = occ.check(); err != nil {
+				return nil, err
+			}
+			occ.mutation = mutation
+			node, err = occ.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(occ.hooks) - 1; i >= 0; i-- {
+			mut = occ.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, occ.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (occ *OwnedCardCreate) SaveX(ctx context.Context) *OwnedCard {
+	v, err := occ.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (occ *OwnedCardCreate) defaults() {
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		v := ownedcard.DefaultCreatedAt()
+		occ.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (occ *OwnedCardCreate) check() error {
+	if _, ok := occ.mutation.Card(); !ok {
+		return &ValidationError{Name: "card", err: errors.New("ent: missing required field \"card\"")}
+	}
+	if v, ok := occ.mutation.Card(); ok {
+		if err := ownedcard.CardValidator(v); err != nil {
+			return &ValidationError{Name: "card", err: fmt.Errorf("ent: validator failed for field \"card\": %w", err)}
+		}
+	}
+	if _, ok := occ.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New("ent: missing required field \"source\"")}
+	}
+	if v, ok := occ.mutation.Source(); ok {
+		if err := ownedcard.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf("ent: validator failed for field \"source\": %w", err)}
+		}
+	}
+	if _, ok := occ.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := occ.mutation.OwnerID(); !ok {
+		return &ValidationError{Name: "owner", err: errors.New("ent: missing required edge \"owner\"")}
+	}
+	return nil
+}
+
+func (occ *OwnedCardCreate) sqlSave(ctx context.Context) (*OwnedCard, error) {
+	_node, _spec := occ.createSpec()
+	if err := sqlgraph.CreateNode(ctx, occ.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (occ *OwnedCardCreate) createSpec() (*OwnedCard, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnedCard{config: occ.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ownedcard.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ownedcard.FieldID,
+			},
+		}
+	)
+	if value, ok := occ.mutation.Card(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ownedcard.FieldCard,
+		})
+		_node.Card = value
+	}
+	if value, ok := occ.mutation.Source(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: ownedcard.FieldSource,
+		})
+		_node.Source = value
+	}
+	if value, ok := occ.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ownedcard.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := occ.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownedcard.OwnerTable,
+			Columns: []string{ownedcard.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OwnedCardCreateBulk is the builder for creating many OwnedCard entities in bulk.
+type OwnedCardCreateBulk struct {
+	config
+	builders []*OwnedCardCreate
+}
+
+// Save creates the OwnedCard entities in the database.
+func (occb *OwnedCardCreateBulk) Save(ctx context.Context) ([]*OwnedCard, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(occb.builders))
+	nodes := make([]*OwnedCard, len(occb.builders))
+	mutators := make([]Mutator, len(occb.builders))
+	for i := range occb.builders {
+		func(i int, root context.Context) {
+			builder := occb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnedCardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, occb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, occb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, occb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (occb *OwnedCardCreateBulk) SaveX(ctx context.Context) []*OwnedCard {
+	v, err := occb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,970 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/ownedcard"
+	"app/ent/player"
+	"app/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnedCardQuery is the builder for querying OwnedCard entities.
+type OwnedCardQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.OwnedCard
+	// eager-loading edges.
+	withOwner *PlayerQuery
+	withFKs   bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OwnedCardQuery builder.
+func (ocq *OwnedCardQuery) Where(ps ...predicate.OwnedCard) *OwnedCardQuery {
+	ocq.predicates = append(ocq.predicates, ps...)
+	return ocq
+}
+
+// Limit adds a limit step to the query.
+func (ocq *OwnedCardQuery) Limit(limit int) *OwnedCardQuery {
+	ocq.limit = &limit
+	return ocq
+}
+
+// Offset adds an offset step to the query.
+func (ocq *OwnedCardQuery) Offset(offset int) *OwnedCardQuery {
+	ocq.offset = &offset
+	return ocq
+}
+
+// Order adds an order step to the query.
+func (ocq *OwnedCardQuery) Order(o ...OrderFunc) *OwnedCardQuery {
+	ocq.order = append(ocq.order, o...)
+	return ocq
+}
+
+// QueryOwner chains the current query on the "owner" edge.
+func (ocq *OwnedCardQuery) QueryOwner() *PlayerQuery {
+	query := &PlayerQuery{config: ocq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ocq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ownedcard.Table, ownedcard.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ownedcard.OwnerTable, ownedcard.OwnerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ocq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OwnedCard entity from the query.
+// Returns a *NotFoundError when no OwnedCard was found.
+func (ocq *OwnedCardQuery) First(ctx context.Context) (*OwnedCard, error) {
+	nodes, err := ocq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ownedcard.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ocq *OwnedCardQuery) FirstX(ctx context.Context) *OwnedCard {
+	node, err := ocq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OwnedCard ID from the query.
+// Returns a *NotFoundError when no OwnedCard ID was found.
+func (ocq *OwnedCardQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ownedcard.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ocq *OwnedCardQuery) FirstIDX(ctx context.Context) int {
+	id, err := ocq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OwnedCard entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one OwnedCard entity is not found.
+// Returns a *NotFoundError when no OwnedCard entities are found.
+func (ocq *OwnedCardQuery) Only(ctx context.Context) (*OwnedCard, error) {
+	nodes, err := ocq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ownedcard.Label}
+	default:
+		return nil, &NotSingularError{ownedcard.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ocq *OwnedCardQuery) OnlyX(ctx context.Context) *OwnedCard {
+	node, err := ocq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OwnedCard ID in the query.
+// Returns a *NotSingularError when exactly one OwnedCard ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (ocq *OwnedCardQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ocq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = &NotSingularError{ownedcard.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ocq *OwnedCardQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ocq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OwnedCards.
+func (ocq *OwnedCardQuery) All(ctx context.Context) ([]*OwnedCard, error) {
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ocq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ocq *OwnedCardQuery) AllX(ctx context.Context) []*OwnedCard {
+	nodes, err := ocq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OwnedCard IDs.
+func (ocq *OwnedCardQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ocq.Select(ownedcard.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ocq *OwnedCardQuery) IDsX(ctx context.Context) []int {
+	ids, err := ocq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ocq *OwnedCardQuery) Count(ctx context.Context) (int, error) {
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ocq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ocq *OwnedCardQuery) CountX(ctx context.Context) int {
+	count, err := ocq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ocq *OwnedCardQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ocq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ocq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ocq *OwnedCardQuery) ExistX(ctx context.Context) bool {
+	exist, err := ocq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OwnedCardQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ocq *OwnedCardQuery) Clone() *OwnedCardQuery {
+	if ocq == nil {
+		return nil
+	}
+	return &OwnedCardQuery{
+		config:     ocq.config,
+		limit:      ocq.limit,
+		offset:     ocq.offset,
+		order:      append([]OrderFunc{}, ocq.order...),
+		predicates: append([]predicate.OwnedCard{}, ocq.predicates...),
+		withOwner:  ocq.withOwner.Clone(),
+		// clone intermediate query.
+		sql:  ocq.sql.Clone(),
+		path: ocq.path,
+	}
+}
+
+// WithOwner tells the query-builder to eager-load the nodes that are connected to
+// the "owner" edge. The optional arguments are used to configure the query builder of the edge.
+func (ocq *OwnedCardQuery) WithOwner(opts ...func(*PlayerQuery)) *OwnedCardQuery {
+	query := &PlayerQuery{config: ocq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ocq.withOwner = query
+	return ocq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Card int `json:"card,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OwnedCard.Query().
+//		GroupBy(ownedcard.FieldCard).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ocq *OwnedCardQuery) GroupBy(field string, fields ...string) *OwnedCardGroupBy {
+	group := &OwnedCardGroupBy{config: ocq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ocq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ocq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Card int `json:"card,omitempty"`
+//	}
+//
+//	client.OwnedCard.Query().
+//		Select(ownedcard.FieldCard).
+//		Scan(ctx, &v)
+//
+func (ocq *OwnedCardQuery) Select(field string, fields ...string) *OwnedCardSelect {
+	ocq.fields = append([]string{field}, fields...)
+	return &OwnedCardSelect{OwnedCardQuery: ocq}
+}
+
+func (ocq *OwnedCardQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range ocq.fields {
+		if !ownedcard.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ocq.path != nil {
+		prev, err := ocq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ocq.sql = prev
+	}
+	return nil
+}
+
+func (ocq *OwnedCardQuery) sqlAll(ctx context.Context) ([]*OwnedCard, error) {
+	var (
+		nodes       = []*OwnedCard{}
+		withFKs     = ocq.withFKs
+		_spec       = ocq.querySpec()
+		loadedTypes = [1]bool{
+			ocq.withOwner != nil,
+		}
+	)
+	if ocq.withOwner != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ownedcard.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &OwnedCard{config: ocq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ocq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ocq.withOwner; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*OwnedCard)
+		for i := range nodes {
+			if fk := nodes[i].player_cards; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(player.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_cards" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Owner = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ocq *OwnedCardQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ocq.querySpec()
+	return sqlgraph.CountNodes(ctx, ocq.driver, _spec)
+}
+
+func (ocq *OwnedCardQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ocq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (ocq *OwnedCardQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ownedcard.Table,
+			Columns: ownedcard.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ownedcard.FieldID,
+			},
+		},
+		From:   ocq.sql,
+		Unique: true,
+	}
+	if fields := ocq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownedcard.FieldID)
+		for i := range fields {
+			if fields[i] != ownedcard.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ocq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ocq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ocq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ocq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, ownedcard.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ocq *OwnedCardQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ocq.driver.Dialect())
+	t1 := builder.Table(ownedcard.Table)
+	selector := builder.Select(t1.Columns(ownedcard.Columns...)...).From(t1)
+	if ocq.sql != nil {
+		selector = ocq.sql
+		selector.Select(selector.Columns(ownedcard.Columns...)...)
+	}
+	for _, p := range ocq.predicates {
+		p(selector)
+	}
+	for _, p := range ocq.order {
+		p(selector, ownedcard.ValidColumn)
+	}
+	if offset := ocq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ocq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OwnedCardGroupBy is the group-by builder for OwnedCard entities.
+type OwnedCardGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ocgb *OwnedCardGroupBy) Aggregate(fns ...AggregateFunc) *OwnedCardGroupBy {
+	ocgb.fns = append(ocgb.fns, fns...)
+	return ocgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (ocgb *OwnedCardGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ocgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ocgb.sql = query
+	return ocgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ocgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ocgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ocgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) StringX(ctx context.Context) string {
+	v, err := ocgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ocgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ocgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) IntX(ctx context.Context) int {
+	v, err := ocgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ocgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ocgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := ocgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ocgb.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ocgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ocgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (ocgb *OwnedCardGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ocgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ocgb *OwnedCardGroupBy) BoolX(ctx context.Context) bool {
+	v, err := ocgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ocgb *OwnedCardGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range ocgb.fields {
+		if !ownedcard.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := ocgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ocgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ocgb *OwnedCardGroupBy) sqlQuery() *sql.Selector {
+	selector := ocgb.sql
+	columns := make([]string, 0, len(ocgb.fields)+len(ocgb.fns))
+	columns = append(columns, ocgb.fields...)
+	for _, fn := range ocgb.fns {
+		columns = append(columns, fn(selector, ownedcard.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(ocgb.fields...)
+}
+
+// OwnedCardSelect is the builder for selecting fields of OwnedCard entities.
+type OwnedCardSelect struct {
+	*OwnedCardQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ocs *OwnedCardSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ocs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ocs.sql = ocs.OwnedCardQuery.sqlQuery(ctx)
+	return ocs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ocs *OwnedCardSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ocs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ocs *OwnedCardSelect) StringsX(ctx context.Context) []string {
+	v, err := ocs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ocs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ocs *OwnedCardSelect) StringX(ctx context.Context) string {
+	v, err := ocs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ocs *OwnedCardSelect) IntsX(ctx context.Context) []int {
+	v, err := ocs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ocs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ocs *OwnedCardSelect) IntX(ctx context.Context) int {
+	v, err := ocs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ocs *OwnedCardSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ocs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ocs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ocs *OwnedCardSelect) Float64X(ctx context.Context) float64 {
+	v, err := ocs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ocs.fields) > 1 {
+		return nil, errors.New("ent: OwnedCardSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ocs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ocs *OwnedCardSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ocs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ocs *OwnedCardSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ocs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ownedcard.Label}
+	default:
+		err = fmt.Errorf("ent: OwnedCardSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ocs *OwnedCardSelect) BoolX(ctx context.Context) bool {
+	v, err := ocs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ocs *OwnedCardSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ocs.sqlQuery().Query()
+	if err := ocs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ocs *OwnedCardSelect) sqlQuery() sql.Querier {
+	selector := ocs.sql
+	selector.Select(selector.Columns(ocs.fields...)...)
+	return selector
+}
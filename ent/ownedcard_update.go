@@ -0,0 +1,446 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/ownedcard"
+	"app/ent/player"
+	"app/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OwnedCardUpdate is the builder for updating OwnedCard entities.
+type OwnedCardUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OwnedCardMutation
+}
+
+// Where adds a new predicate for the OwnedCardUpdate builder.
+func (ocu *OwnedCardUpdate) Where(ps ...predicate.OwnedCard) *OwnedCardUpdate {
+	ocu.mutation.predicates = append(ocu.mutation.predicates, ps...)
+	return ocu
+}
+
+// SetCard sets the "card" field.
+func (ocu *OwnedCardUpdate) SetCard(i int) *OwnedCardUpdate {
+	ocu.mutation.ResetCard()
+	ocu.mutation.SetCard(i)
+	return ocu
+}
+
+// AddCard adds i to the "card" field.
+func (ocu *OwnedCardUpdate) AddCard(i int) *OwnedCardUpdate {
+	ocu.mutation.AddCard(i)
+	return ocu
+}
+
+// SetSource sets the "source" field.
+func (ocu *OwnedCardUpdate) SetSource(o ownedcard.Source) *OwnedCardUpdate {
+	ocu.mutation.SetSource(o)
+	return ocu
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by ID.
+func (ocu *OwnedCardUpdate) SetOwnerID(id int) *OwnedCardUpdate {
+	ocu.mutation.SetOwnerID(id)
+	return ocu
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (ocu *OwnedCardUpdate) SetOwner(p *Player) *OwnedCardUpdate {
+	return ocu.SetOwnerID(p.ID)
+}
+
+// Mutation returns the OwnedCardMutation object of the builder.
+func (ocu *OwnedCardUpdate) Mutation() *OwnedCardMutation {
+	return ocu.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (ocu *OwnedCardUpdate) ClearOwner() *OwnedCardUpdate {
+	ocu.mutation.ClearOwner()
+	return ocu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ocu *OwnedCardUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ocu.hooks) == 0 {
+		if err = ocu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = ocu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnedCardMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ocu.check(); err != nil {
+				return 0, err
+			}
+			ocu.mutation = mutation
+			affected, err = ocu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(ocu.hooks) - 1; i >= 0; i-- {
+			mut = ocu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocu *OwnedCardUpdate) SaveX(ctx context.Context) int {
+	affected, err := ocu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ocu *OwnedCardUpdate) Exec(ctx context.Context) error {
+	_, err := ocu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocu *OwnedCardUpdate) ExecX(ctx context.Context) {
+	if err := ocu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocu *OwnedCardUpdate) check() error {
+	if v, ok := ocu.mutation.Card(); ok {
+		if err := ownedcard.CardValidator(v); err != nil {
+			return &ValidationError{Name: "card", err: fmt.Errorf("ent: validator failed for field \"card\": %w", err)}
+		}
+	}
+	if v, ok := ocu.mutation.Source(); ok {
+		if err := ownedcard.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf("ent: validator failed for field \"source\": %w", err)}
+		}
+	}
+	if _, ok := ocu.mutation.OwnerID(); ocu.mutation.OwnerCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"owner\"")
+	}
+	return nil
+}
+
+func (ocu *OwnedCardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ownedcard.Table,
+			Columns: ownedcard.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ownedcard.FieldID,
+			},
+		},
+	}
+	if ps := ocu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocu.mutation.Card(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ownedcard.FieldCard,
+		})
+	}
+	if value, ok := ocu.mutation.AddedCard(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ownedcard.FieldCard,
+		})
+	}
+	if value, ok := ocu.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: ownedcard.FieldSource,
+		})
+	}
+	if ocu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownedcard.OwnerTable,
+			Columns: []string{ownedcard.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownedcard.OwnerTable,
+			Columns: []string{ownedcard.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ocu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownedcard.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// OwnedCardUpdateOne is the builder for updating a single OwnedCard entity.
+type OwnedCardUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *OwnedCardMutation
+}
+
+// SetCard sets the "card" field.
+func (ocuo *OwnedCardUpdateOne) SetCard(i int) *OwnedCardUpdateOne {
+	ocuo.mutation.ResetCard()
+	ocuo.mutation.SetCard(i)
+	return ocuo
+}
+
+// AddCard adds i to the "card" field.
+func (ocuo *OwnedCardUpdateOne) AddCard(i int) *OwnedCardUpdateOne {
+	ocuo.mutation.AddCard(i)
+	return ocuo
+}
+
+// SetSource sets the "source" field.
+func (ocuo *OwnedCardUpdateOne) SetSource(o ownedcard.Source) *OwnedCardUpdateOne {
+	ocuo.mutation.SetSource(o)
+	return ocuo
+}
+
+// SetOwnerID sets the "owner" edge to the Player entity by ID.
+func (ocuo *OwnedCardUpdateOne) SetOwnerID(id int) *OwnedCardUpdateOne {
+	ocuo.mutation.SetOwnerID(id)
+	return ocuo
+}
+
+// SetOwner sets the "owner" edge to the Player entity.
+func (ocuo *OwnedCardUpdateOne) SetOwner(p *Player) *OwnedCardUpdateOne {
+	return ocuo.SetOwnerID(p.ID)
+}
+
+// Mutation returns the OwnedCardMutation object of the builder.
+func (ocuo *OwnedCardUpdateOne) Mutation() *OwnedCardMutation {
+	return ocuo.mutation
+}
+
+// ClearOwner clears the "owner" edge to the Player entity.
+func (ocuo *OwnedCardUpdateOne) ClearOwner() *OwnedCardUpdateOne {
+	ocuo.mutation.ClearOwner()
+	return ocuo
+}
+
+// Save executes the query and returns the updated OwnedCard entity.
+func (ocuo *OwnedCardUpdateOne) Save(ctx context.Context) (*OwnedCard, error) {
+	var (
+		err  error
+		node *OwnedCard
+	)
+	if len(ocuo.hooks) == 0 {
+		if err = ocuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = ocuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*OwnedCardMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = ocuo.check(); err != nil {
+				return nil, err
+			}
+			ocuo.mutation = mutation
+			node, err = ocuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(ocuo.hooks) - 1; i >= 0; i-- {
+			mut = ocuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ocuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocuo *OwnedCardUpdateOne) SaveX(ctx context.Context) *OwnedCard {
+	node, err := ocuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ocuo *OwnedCardUpdateOne) Exec(ctx context.Context) error {
+	_, err := ocuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocuo *OwnedCardUpdateOne) ExecX(ctx context.Context) {
+	if err := ocuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ocuo *OwnedCardUpdateOne) check() error {
+	if v, ok := ocuo.mutation.Card(); ok {
+		if err := ownedcard.CardValidator(v); err != nil {
+			return &ValidationError{Name: "card", err: fmt.Errorf("ent: validator failed for field \"card\": %w", err)}
+		}
+	}
+	if v, ok := ocuo.mutation.Source(); ok {
+		if err := ownedcard.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf("ent: validator failed for field \"source\": %w", err)}
+		}
+	}
+	if _, ok := ocuo.mutation.OwnerID(); ocuo.mutation.OwnerCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"owner\"")
+	}
+	return nil
+}
+
+func (ocuo *OwnedCardUpdateOne) sqlSave(ctx context.Context) (_node *OwnedCard, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ownedcard.Table,
+			Columns: ownedcard.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ownedcard.FieldID,
+			},
+		},
+	}
+	id, ok := ocuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing OwnedCard.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := ocuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ocuo.mutation.Card(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ownedcard.FieldCard,
+		})
+	}
+	if value, ok := ocuo.mutation.AddedCard(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ownedcard.FieldCard,
+		})
+	}
+	if value, ok := ocuo.mutation.Source(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: ownedcard.FieldSource,
+		})
+	}
+	if ocuo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownedcard.OwnerTable,
+			Columns: []string{ownedcard.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ocuo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownedcard.OwnerTable,
+			Columns: []string{ownedcard.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OwnedCard{config: ocuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ocuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownedcard.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
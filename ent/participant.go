@@ -0,0 +1,211 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/game"
+	"app/ent/participant"
+	"app/ent/player"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Participant is the model entity for the Participant schema.
+type Participant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Team holds the value of the "team" field.
+	Team int `json:"team,omitempty"`
+	// Deck holds the value of the "deck" field.
+	Deck []uint32 `json:"deck,omitempty"`
+	// Left holds the value of the "left" field.
+	Left bool `json:"left,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ParticipantQuery when eager-loading is set.
+	Edges                 ParticipantEdges `json:"edges"`
+	game_participants     *int
+	player_participations *int
+}
+
+// ParticipantEdges holds the relations/edges for other nodes in the graph.
+type ParticipantEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ParticipantEdges) GameOrErr() (*Game, error) {
+	if e.loadedTypes[0] {
+		if e.Game == nil {
+			// The edge game was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: game.Label}
+		}
+		return e.Game, nil
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ParticipantEdges) PlayerOrErr() (*Player, error) {
+	if e.loadedTypes[1] {
+		if e.Player == nil {
+			// The edge player was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: player.Label}
+		}
+		return e.Player, nil
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Participant) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case participant.FieldDeck:
+			values[i] = &[]byte{}
+		case participant.FieldLeft:
+			values[i] = &sql.NullBool{}
+		case participant.FieldID, participant.FieldTeam:
+			values[i] = &sql.NullInt64{}
+		case participant.FieldName:
+			values[i] = &sql.NullString{}
+		case participant.ForeignKeys[0]: // game_participants
+			values[i] = &sql.NullInt64{}
+		case participant.ForeignKeys[1]: // player_participations
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type Participant", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Participant fields.
+func (pa *Participant) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case participant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pa.ID = int(value.Int64)
+		case participant.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pa.Name = value.String
+			}
+		case participant.FieldTeam:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team", values[i])
+			} else if value.Valid {
+				pa.Team = int(value.Int64)
+			}
+		case participant.FieldDeck:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field deck", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Deck); err != nil {
+					return fmt.Errorf("unmarshal field deck: %v", err)
+				}
+			}
+		case participant.FieldLeft:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field left", values[i])
+			} else if value.Valid {
+				pa.Left = value.Bool
+			}
+		case participant.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field game_participants", value)
+			} else if value.Valid {
+				pa.game_participants = new(int)
+				*pa.game_participants = int(value.Int64)
+			}
+		case participant.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_participations", value)
+			} else if value.Valid {
+				pa.player_participations = new(int)
+				*pa.player_participations = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryGame queries the "game" edge of the Participant entity.
+func (pa *Participant) QueryGame() *GameQuery {
+	return (&ParticipantClient{config: pa.config}).QueryGame(pa)
+}
+
+// QueryPlayer queries the "player" edge of the Participant entity.
+func (pa *Participant) QueryPlayer() *PlayerQuery {
+	return (&ParticipantClient{config: pa.config}).QueryPlayer(pa)
+}
+
+// Update returns a builder for updating this Participant.
+// Note that you need to call Participant.Unwrap() before calling this method if this Participant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Participant) Update() *ParticipantUpdateOne {
+	return (&ParticipantClient{config: pa.config}).UpdateOne(pa)
+}
+
+// Unwrap unwraps the Participant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *Participant) Unwrap() *Participant {
+	tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Participant is not a transactional entity")
+	}
+	pa.config.driver = tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Participant) String() string {
+	var builder strings.Builder
+	builder.WriteString("Participant(")
+	builder.WriteString(fmt.Sprintf("id=%v", pa.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(pa.Name)
+	builder.WriteString(", team=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Team))
+	builder.WriteString(", deck=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Deck))
+	builder.WriteString(", left=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Left))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Participants is a parsable slice of Participant.
+type Participants []*Participant
+
+func (pa Participants) config(cfg config) {
+	for _i := range pa {
+		pa[_i].config = cfg
+	}
+}
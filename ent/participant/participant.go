@@ -0,0 +1,79 @@
+// Code generated by entc, DO NOT EDIT.
+
+package participant
+
+const (
+	// Label holds the string label denoting the participant type in the database.
+	Label = "participant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTeam holds the string denoting the team field in the database.
+	FieldTeam = "team"
+	// FieldDeck holds the string denoting the deck field in the database.
+	FieldDeck = "deck"
+	// FieldLeft holds the string denoting the left field in the database.
+	FieldLeft = "left"
+
+	// EdgeGame holds the string denoting the game edge name in mutations.
+	EdgeGame = "game"
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+
+	// Table holds the table name of the participant in the database.
+	Table = "participants"
+	// GameTable is the table the holds the game relation/edge.
+	GameTable = "participants"
+	// GameInverseTable is the table name for the Game entity.
+	// It exists in this package in order to avoid circular dependency with the "game" package.
+	GameInverseTable = "games"
+	// GameColumn is the table column denoting the game relation/edge.
+	GameColumn = "game_participants"
+	// PlayerTable is the table the holds the player relation/edge.
+	PlayerTable = "participants"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_participations"
+)
+
+// Columns holds all SQL columns for participant fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTeam,
+	FieldDeck,
+	FieldLeft,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Participant type.
+var ForeignKeys = []string{
+	"game_participants",
+	"player_participations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// TeamValidator is a validator for the "team" field. It is called by the builders before save.
+	TeamValidator func(int) error
+	// DefaultLeft holds the default value on creation for the "left" field.
+	DefaultLeft bool
+)
@@ -0,0 +1,338 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/game"
+	"app/ent/participant"
+	"app/ent/player"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ParticipantCreate is the builder for creating a Participant entity.
+type ParticipantCreate struct {
+	config
+	mutation *ParticipantMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *ParticipantCreate) SetName(s string) *ParticipantCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pc *ParticipantCreate) SetNillableName(s *string) *ParticipantCreate {
+	if s != nil {
+		pc.SetName(*s)
+	}
+	return pc
+}
+
+// SetTeam sets the "team" field.
+func (pc *ParticipantCreate) SetTeam(i int) *ParticipantCreate {
+	pc.mutation.SetTeam(i)
+	return pc
+}
+
+// SetDeck sets the "deck" field.
+func (pc *ParticipantCreate) SetDeck(u []uint32) *ParticipantCreate {
+	pc.mutation.SetDeck(u)
+	return pc
+}
+
+// SetLeft sets the "left" field.
+func (pc *ParticipantCreate) SetLeft(b bool) *ParticipantCreate {
+	pc.mutation.SetLeft(b)
+	return pc
+}
+
+// SetNillableLeft sets the "left" field if the given value is not nil.
+func (pc *ParticipantCreate) SetNillableLeft(b *bool) *ParticipantCreate {
+	if b != nil {
+		pc.SetLeft(*b)
+	}
+	return pc
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (pc *ParticipantCreate) SetGameID(id int) *ParticipantCreate {
+	pc.mutation.SetGameID(id)
+	return pc
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (pc *ParticipantCreate) SetGame(g *Game) *ParticipantCreate {
+	return pc.SetGameID(g.ID)
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (pc *ParticipantCreate) SetPlayerID(id int) *ParticipantCreate {
+	pc.mutation.SetPlayerID(id)
+	return pc
+}
+
+// SetNillablePlayerID sets the "player" edge to the Player entity by ID if the given value is not nil.
+func (pc *ParticipantCreate) SetNillablePlayerID(id *int) *ParticipantCreate {
+	if id != nil {
+		pc = pc.SetPlayerID(*id)
+	}
+	return pc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (pc *ParticipantCreate) SetPlayer(p *Player) *ParticipantCreate {
+	return pc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the ParticipantMutation object of the builder.
+func (pc *ParticipantCreate) Mutation() *ParticipantMutation {
+	return pc.mutation
+}
+
+// Save creates the Participant in the database.
+func (pc *ParticipantCreate) Save(ctx context.Context) (*Participant, error) {
+	var (
+		err  error
+		node *Participant
+	)
+	pc.defaults()
+	if len(pc.hooks) == 0 {
+		if err = pc.check(); err != nil {
+			return nil, err
+		}
+		node, err = pc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ParticipantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pc.check(); err != nil {
+				return nil, err
+			}
+			pc.mutation = mutation
+			node, err = pc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(pc.hooks) - 1; i >= 0; i-- {
+			mut = pc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ParticipantCreate) SaveX(ctx context.Context) *Participant {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ParticipantCreate) defaults() {
+	if _, ok := pc.mutation.Left(); !ok {
+		v := participant.DefaultLeft
+		pc.mutation.SetLeft(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ParticipantCreate) check() error {
+	if v, ok := pc.mutation.Name(); ok {
+		if err := participant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Team(); !ok {
+		return &ValidationError{Name: "team", err: errors.New("ent: missing required field \"team\"")}
+	}
+	if v, ok := pc.mutation.Team(); ok {
+		if err := participant.TeamValidator(v); err != nil {
+			return &ValidationError{Name: "team", err: fmt.Errorf("ent: validator failed for field \"team\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.Left(); !ok {
+		return &ValidationError{Name: "left", err: errors.New("ent: missing required field \"left\"")}
+	}
+	if _, ok := pc.mutation.GameID(); !ok {
+		return &ValidationError{Name: "game", err: errors.New("ent: missing required edge \"game\"")}
+	}
+	return nil
+}
+
+func (pc *ParticipantCreate) sqlSave(ctx context.Context) (*Participant, error) {
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (pc *ParticipantCreate) createSpec() (*Participant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Participant{config: pc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: participant.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: participant.FieldID,
+			},
+		}
+	)
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: participant.FieldName,
+		})
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.Team(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: participant.FieldTeam,
+		})
+		_node.Team = value
+	}
+	if value, ok := pc.mutation.Deck(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: participant.FieldDeck,
+		})
+		_node.Deck = value
+	}
+	if value, ok := pc.mutation.Left(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: participant.FieldLeft,
+		})
+		_node.Left = value
+	}
+	if nodes := pc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.GameTable,
+			Columns: []string{participant.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.PlayerTable,
+			Columns: []string{participant.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ParticipantCreateBulk is the builder for creating many Participant entities in bulk.
+type ParticipantCreateBulk struct {
+	config
+	builders []*ParticipantCreate
+}
+
+// Save creates the Participant entities in the database.
+func (pcb *ParticipantCreateBulk) Save(ctx context.Context) ([]*Participant, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Participant, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ParticipantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ParticipantCreateBulk) SaveX(ctx context.Context) []*Participant {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,699 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/game"
+	"app/ent/participant"
+	"app/ent/player"
+	"app/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ParticipantUpdate is the builder for updating Participant entities.
+type ParticipantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ParticipantMutation
+}
+
+// Where adds a new predicate for the ParticipantUpdate builder.
+func (pu *ParticipantUpdate) Where(ps ...predicate.Participant) *ParticipantUpdate {
+	pu.mutation.predicates = append(pu.mutation.predicates, ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *ParticipantUpdate) SetName(s string) *ParticipantUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *ParticipantUpdate) SetNillableName(s *string) *ParticipantUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// ClearName clears the value of the "name" field.
+func (pu *ParticipantUpdate) ClearName() *ParticipantUpdate {
+	pu.mutation.ClearName()
+	return pu
+}
+
+// SetTeam sets the "team" field.
+func (pu *ParticipantUpdate) SetTeam(i int) *ParticipantUpdate {
+	pu.mutation.ResetTeam()
+	pu.mutation.SetTeam(i)
+	return pu
+}
+
+// AddTeam adds i to the "team" field.
+func (pu *ParticipantUpdate) AddTeam(i int) *ParticipantUpdate {
+	pu.mutation.AddTeam(i)
+	return pu
+}
+
+// SetDeck sets the "deck" field.
+func (pu *ParticipantUpdate) SetDeck(u []uint32) *ParticipantUpdate {
+	pu.mutation.SetDeck(u)
+	return pu
+}
+
+// ClearDeck clears the value of the "deck" field.
+func (pu *ParticipantUpdate) ClearDeck() *ParticipantUpdate {
+	pu.mutation.ClearDeck()
+	return pu
+}
+
+// SetLeft sets the "left" field.
+func (pu *ParticipantUpdate) SetLeft(b bool) *ParticipantUpdate {
+	pu.mutation.SetLeft(b)
+	return pu
+}
+
+// SetNillableLeft sets the "left" field if the given value is not nil.
+func (pu *ParticipantUpdate) SetNillableLeft(b *bool) *ParticipantUpdate {
+	if b != nil {
+		pu.SetLeft(*b)
+	}
+	return pu
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (pu *ParticipantUpdate) SetGameID(id int) *ParticipantUpdate {
+	pu.mutation.SetGameID(id)
+	return pu
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (pu *ParticipantUpdate) SetGame(g *Game) *ParticipantUpdate {
+	return pu.SetGameID(g.ID)
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (pu *ParticipantUpdate) SetPlayerID(id int) *ParticipantUpdate {
+	pu.mutation.SetPlayerID(id)
+	return pu
+}
+
+// SetNillablePlayerID sets the "player" edge to the Player entity by ID if the given value is not nil.
+func (pu *ParticipantUpdate) SetNillablePlayerID(id *int) *ParticipantUpdate {
+	if id != nil {
+		pu = pu.SetPlayerID(*id)
+	}
+	return pu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (pu *ParticipantUpdate) SetPlayer(p *Player) *ParticipantUpdate {
+	return pu.SetPlayerID(p.ID)
+}
+
+// Mutation returns the ParticipantMutation object of the builder.
+func (pu *ParticipantUpdate) Mutation() *ParticipantMutation {
+	return pu.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (pu *ParticipantUpdate) ClearGame() *ParticipantUpdate {
+	pu.mutation.ClearGame()
+	return pu
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (pu *ParticipantUpdate) ClearPlayer() *ParticipantUpdate {
+	pu.mutation.ClearPlayer()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *ParticipantUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(pu.hooks) == 0 {
+		if err = pu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = pu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ParticipantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = pu.check(); err != nil {
+				return 0, err
+			}
+			pu.mutation = mutation
+			affected, err = pu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(pu.hooks) - 1; i >= 0; i-- {
+			mut = pu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, pu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ParticipantUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ParticipantUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ParticipantUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ParticipantUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := participant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := pu.mutation.Team(); ok {
+		if err := participant.TeamValidator(v); err != nil {
+			return &ValidationError{Name: "team", err: fmt.Errorf("ent: validator failed for field \"team\": %w", err)}
+		}
+	}
+	if _, ok := pu.mutation.GameID(); pu.mutation.GameCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"game\"")
+	}
+	return nil
+}
+
+func (pu *ParticipantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   participant.Table,
+			Columns: participant.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: participant.FieldID,
+			},
+		},
+	}
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: participant.FieldName,
+		})
+	}
+	if pu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: participant.FieldName,
+		})
+	}
+	if value, ok := pu.mutation.Team(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: participant.FieldTeam,
+		})
+	}
+	if value, ok := pu.mutation.AddedTeam(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: participant.FieldTeam,
+		})
+	}
+	if value, ok := pu.mutation.Deck(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: participant.FieldDeck,
+		})
+	}
+	if pu.mutation.DeckCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: participant.FieldDeck,
+		})
+	}
+	if value, ok := pu.mutation.Left(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: participant.FieldLeft,
+		})
+	}
+	if pu.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.GameTable,
+			Columns: []string{participant.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.GameTable,
+			Columns: []string{participant.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.PlayerTable,
+			Columns: []string{participant.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.PlayerTable,
+			Columns: []string{participant.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{participant.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ParticipantUpdateOne is the builder for updating a single Participant entity.
+type ParticipantUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ParticipantMutation
+}
+
+// SetName sets the "name" field.
+func (puo *ParticipantUpdateOne) SetName(s string) *ParticipantUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *ParticipantUpdateOne) SetNillableName(s *string) *ParticipantUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// ClearName clears the value of the "name" field.
+func (puo *ParticipantUpdateOne) ClearName() *ParticipantUpdateOne {
+	puo.mutation.ClearName()
+	return puo
+}
+
+// SetTeam sets the "team" field.
+func (puo *ParticipantUpdateOne) SetTeam(i int) *ParticipantUpdateOne {
+	puo.mutation.ResetTeam()
+	puo.mutation.SetTeam(i)
+	return puo
+}
+
+// AddTeam adds i to the "team" field.
+func (puo *ParticipantUpdateOne) AddTeam(i int) *ParticipantUpdateOne {
+	puo.mutation.AddTeam(i)
+	return puo
+}
+
+// SetDeck sets the "deck" field.
+func (puo *ParticipantUpdateOne) SetDeck(u []uint32) *ParticipantUpdateOne {
+	puo.mutation.SetDeck(u)
+	return puo
+}
+
+// ClearDeck clears the value of the "deck" field.
+func (puo *ParticipantUpdateOne) ClearDeck() *ParticipantUpdateOne {
+	puo.mutation.ClearDeck()
+	return puo
+}
+
+// SetLeft sets the "left" field.
+func (puo *ParticipantUpdateOne) SetLeft(b bool) *ParticipantUpdateOne {
+	puo.mutation.SetLeft(b)
+	return puo
+}
+
+// SetNillableLeft sets the "left" field if the given value is not nil.
+func (puo *ParticipantUpdateOne) SetNillableLeft(b *bool) *ParticipantUpdateOne {
+	if b != nil {
+		puo.SetLeft(*b)
+	}
+	return puo
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (puo *ParticipantUpdateOne) SetGameID(id int) *ParticipantUpdateOne {
+	puo.mutation.SetGameID(id)
+	return puo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (puo *ParticipantUpdateOne) SetGame(g *Game) *ParticipantUpdateOne {
+	return puo.SetGameID(g.ID)
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (puo *ParticipantUpdateOne) SetPlayerID(id int) *ParticipantUpdateOne {
+	puo.mutation.SetPlayerID(id)
+	return puo
+}
+
+// SetNillablePlayerID sets the "player" edge to the Player entity by ID if the given value is not nil.
+func (puo *ParticipantUpdateOne) SetNillablePlayerID(id *int) *ParticipantUpdateOne {
+	if id != nil {
+		puo = puo.SetPlayerID(*id)
+	}
+	return puo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (puo *ParticipantUpdateOne) SetPlayer(p *Player) *ParticipantUpdateOne {
+	return puo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the ParticipantMutation object of the builder.
+func (puo *ParticipantUpdateOne) Mutation() *ParticipantMutation {
+	return puo.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (puo *ParticipantUpdateOne) ClearGame() *ParticipantUpdateOne {
+	puo.mutation.ClearGame()
+	return puo
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (puo *ParticipantUpdateOne) ClearPlayer() *ParticipantUpdateOne {
+	puo.mutation.ClearPlayer()
+	return puo
+}
+
+// Save executes the query and returns the updated Participant entity.
+func (puo *ParticipantUpdateOne) Save(ctx context.Context) (*Participant, error) {
+	var (
+		err  error
+		node *Participant
+	)
+	if len(puo.hooks) == 0 {
+		if err = puo.check(); err != nil {
+			return nil, err
+		}
+		node, err = puo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ParticipantMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = puo.check(); err != nil {
+				return nil, err
+			}
+			puo.mutation = mutation
+			node, err = puo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(puo.hooks) - 1; i >= 0; i-- {
+			mut = puo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, puo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ParticipantUpdateOne) SaveX(ctx context.Context) *Participant {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ParticipantUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ParticipantUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ParticipantUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := participant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
+		}
+	}
+	if v, ok := puo.mutation.Team(); ok {
+		if err := participant.TeamValidator(v); err != nil {
+			return &ValidationError{Name: "team", err: fmt.Errorf("ent: validator failed for field \"team\": %w", err)}
+		}
+	}
+	if _, ok := puo.mutation.GameID(); puo.mutation.GameCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"game\"")
+	}
+	return nil
+}
+
+func (puo *ParticipantUpdateOne) sqlSave(ctx context.Context) (_node *Participant, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   participant.Table,
+			Columns: participant.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: participant.FieldID,
+			},
+		},
+	}
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Participant.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: participant.FieldName,
+		})
+	}
+	if puo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: participant.FieldName,
+		})
+	}
+	if value, ok := puo.mutation.Team(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: participant.FieldTeam,
+		})
+	}
+	if value, ok := puo.mutation.AddedTeam(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: participant.FieldTeam,
+		})
+	}
+	if value, ok := puo.mutation.Deck(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: participant.FieldDeck,
+		})
+	}
+	if puo.mutation.DeckCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: participant.FieldDeck,
+		})
+	}
+	if value, ok := puo.mutation.Left(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: participant.FieldLeft,
+		})
+	}
+	if puo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.GameTable,
+			Columns: []string{participant.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.GameTable,
+			Columns: []string{participant.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: game.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.PlayerTable,
+			Columns: []string{participant.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   participant.PlayerTable,
+			Columns: []string{participant.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Participant{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{participant.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -20,6 +20,20 @@ type Player struct {
 	Type int `json:"type,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Rating holds the value of the "rating" field.
+	Rating int `json:"rating,omitempty"`
+	// DefenderRating holds the value of the "defender_rating" field.
+	DefenderRating int `json:"defender_rating,omitempty"`
+	// AttackerRating holds the value of the "attacker_rating" field.
+	AttackerRating int `json:"attacker_rating,omitempty"`
+	// SemoRating holds the value of the "semo_rating" field.
+	SemoRating int `json:"semo_rating,omitempty"`
+	// Experience holds the value of the "experience" field.
+	Experience int `json:"experience,omitempty"`
+	// StarterGranted holds the value of the "starter_granted" field.
+	StarterGranted bool `json:"starter_granted,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlayerQuery when eager-loading is set.
 	Edges        PlayerEdges `json:"edges"`
@@ -30,9 +44,17 @@ type Player struct {
 type PlayerEdges struct {
 	// Game holds the value of the game edge.
 	Game *Game `json:"game,omitempty"`
+	// Ratings holds the value of the ratings edge.
+	Ratings []*RatingChange `json:"ratings,omitempty"`
+	// Participations holds the value of the participations edge.
+	Participations []*Participant `json:"participations,omitempty"`
+	// Decks holds the value of the decks edge.
+	Decks []*Deck `json:"decks,omitempty"`
+	// Cards holds the value of the cards edge.
+	Cards []*OwnedCard `json:"cards,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
 // GameOrErr returns the Game value or an error if the edge
@@ -49,14 +71,52 @@ func (e PlayerEdges) GameOrErr() (*Game, error) {
 	return nil, &NotLoadedError{edge: "game"}
 }
 
+// RatingsOrErr returns the Ratings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) RatingsOrErr() ([]*RatingChange, error) {
+	if e.loadedTypes[1] {
+		return e.Ratings, nil
+	}
+	return nil, &NotLoadedError{edge: "ratings"}
+}
+
+// ParticipationsOrErr returns the Participations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) ParticipationsOrErr() ([]*Participant, error) {
+	if e.loadedTypes[2] {
+		return e.Participations, nil
+	}
+	return nil, &NotLoadedError{edge: "participations"}
+}
+
+// DecksOrErr returns the Decks value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) DecksOrErr() ([]*Deck, error) {
+	if e.loadedTypes[3] {
+		return e.Decks, nil
+	}
+	return nil, &NotLoadedError{edge: "decks"}
+}
+
+// CardsOrErr returns the Cards value or an error if the edge
+// was not loaded in eager-loading.
+func (e PlayerEdges) CardsOrErr() ([]*OwnedCard, error) {
+	if e.loadedTypes[4] {
+		return e.Cards, nil
+	}
+	return nil, &NotLoadedError{edge: "cards"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Player) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case player.FieldID, player.FieldType:
+		case player.FieldStarterGranted:
+			values[i] = &sql.NullBool{}
+		case player.FieldID, player.FieldType, player.FieldRating, player.FieldDefenderRating, player.FieldAttackerRating, player.FieldSemoRating, player.FieldExperience:
 			values[i] = &sql.NullInt64{}
-		case player.FieldName:
+		case player.FieldName, player.FieldSecret:
 			values[i] = &sql.NullString{}
 		case player.ForeignKeys[0]: // game_players
 			values[i] = &sql.NullInt64{}
@@ -93,6 +153,48 @@ func (pl *Player) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pl.Name = value.String
 			}
+		case player.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				pl.Rating = int(value.Int64)
+			}
+		case player.FieldDefenderRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field defender_rating", values[i])
+			} else if value.Valid {
+				pl.DefenderRating = int(value.Int64)
+			}
+		case player.FieldAttackerRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attacker_rating", values[i])
+			} else if value.Valid {
+				pl.AttackerRating = int(value.Int64)
+			}
+		case player.FieldSemoRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field semo_rating", values[i])
+			} else if value.Valid {
+				pl.SemoRating = int(value.Int64)
+			}
+		case player.FieldExperience:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field experience", values[i])
+			} else if value.Valid {
+				pl.Experience = int(value.Int64)
+			}
+		case player.FieldStarterGranted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field starter_granted", values[i])
+			} else if value.Valid {
+				pl.StarterGranted = value.Bool
+			}
+		case player.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				pl.Secret = value.String
+			}
 		case player.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field game_players", value)
@@ -110,6 +212,26 @@ func (pl *Player) QueryGame() *GameQuery {
 	return (&PlayerClient{config: pl.config}).QueryGame(pl)
 }
 
+// QueryRatings queries the "ratings" edge of the Player entity.
+func (pl *Player) QueryRatings() *RatingChangeQuery {
+	return (&PlayerClient{config: pl.config}).QueryRatings(pl)
+}
+
+// QueryParticipations queries the "participations" edge of the Player entity.
+func (pl *Player) QueryParticipations() *ParticipantQuery {
+	return (&PlayerClient{config: pl.config}).QueryParticipations(pl)
+}
+
+// QueryDecks queries the "decks" edge of the Player entity.
+func (pl *Player) QueryDecks() *DeckQuery {
+	return (&PlayerClient{config: pl.config}).QueryDecks(pl)
+}
+
+// QueryCards queries the "cards" edge of the Player entity.
+func (pl *Player) QueryCards() *OwnedCardQuery {
+	return (&PlayerClient{config: pl.config}).QueryCards(pl)
+}
+
 // Update returns a builder for updating this Player.
 // Note that you need to call Player.Unwrap() before calling this method if this Player
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -137,6 +259,19 @@ func (pl *Player) String() string {
 	builder.WriteString(fmt.Sprintf("%v", pl.Type))
 	builder.WriteString(", name=")
 	builder.WriteString(pl.Name)
+	builder.WriteString(", rating=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Rating))
+	builder.WriteString(", defender_rating=")
+	builder.WriteString(fmt.Sprintf("%v", pl.DefenderRating))
+	builder.WriteString(", attacker_rating=")
+	builder.WriteString(fmt.Sprintf("%v", pl.AttackerRating))
+	builder.WriteString(", semo_rating=")
+	builder.WriteString(fmt.Sprintf("%v", pl.SemoRating))
+	builder.WriteString(", experience=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Experience))
+	builder.WriteString(", starter_granted=")
+	builder.WriteString(fmt.Sprintf("%v", pl.StarterGranted))
+	builder.WriteString(", secret=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
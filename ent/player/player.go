@@ -11,9 +11,31 @@ const (
 	FieldType = "type"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldDefenderRating holds the string denoting the defender_rating field in the database.
+	FieldDefenderRating = "defender_rating"
+	// FieldAttackerRating holds the string denoting the attacker_rating field in the database.
+	FieldAttackerRating = "attacker_rating"
+	// FieldSemoRating holds the string denoting the semo_rating field in the database.
+	FieldSemoRating = "semo_rating"
+	// FieldExperience holds the string denoting the experience field in the database.
+	FieldExperience = "experience"
+	// FieldStarterGranted holds the string denoting the starter_granted field in the database.
+	FieldStarterGranted = "starter_granted"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
 
 	// EdgeGame holds the string denoting the game edge name in mutations.
 	EdgeGame = "game"
+	// EdgeRatings holds the string denoting the ratings edge name in mutations.
+	EdgeRatings = "ratings"
+	// EdgeParticipations holds the string denoting the participations edge name in mutations.
+	EdgeParticipations = "participations"
+	// EdgeDecks holds the string denoting the decks edge name in mutations.
+	EdgeDecks = "decks"
+	// EdgeCards holds the string denoting the cards edge name in mutations.
+	EdgeCards = "cards"
 
 	// Table holds the table name of the player in the database.
 	Table = "players"
@@ -24,6 +46,34 @@ const (
 	GameInverseTable = "games"
 	// GameColumn is the table column denoting the game relation/edge.
 	GameColumn = "game_players"
+	// RatingsTable is the table the holds the ratings relation/edge.
+	RatingsTable = "rating_changes"
+	// RatingsInverseTable is the table name for the RatingChange entity.
+	// It exists in this package in order to avoid circular dependency with the "ratingchange" package.
+	RatingsInverseTable = "rating_changes"
+	// RatingsColumn is the table column denoting the ratings relation/edge.
+	RatingsColumn = "player_ratings"
+	// ParticipationsTable is the table the holds the participations relation/edge.
+	ParticipationsTable = "participants"
+	// ParticipationsInverseTable is the table name for the Participant entity.
+	// It exists in this package in order to avoid circular dependency with the "participant" package.
+	ParticipationsInverseTable = "participants"
+	// ParticipationsColumn is the table column denoting the participations relation/edge.
+	ParticipationsColumn = "player_participations"
+	// DecksTable is the table the holds the decks relation/edge.
+	DecksTable = "decks"
+	// DecksInverseTable is the table name for the Deck entity.
+	// It exists in this package in order to avoid circular dependency with the "deck" package.
+	DecksInverseTable = "decks"
+	// DecksColumn is the table column denoting the decks relation/edge.
+	DecksColumn = "player_decks"
+	// CardsTable is the table the holds the cards relation/edge.
+	CardsTable = "owned_cards"
+	// CardsInverseTable is the table name for the OwnedCard entity.
+	// It exists in this package in order to avoid circular dependency with the "ownedcard" package.
+	CardsInverseTable = "owned_cards"
+	// CardsColumn is the table column denoting the cards relation/edge.
+	CardsColumn = "player_cards"
 )
 
 // Columns holds all SQL columns for player fields.
@@ -31,6 +81,13 @@ var Columns = []string{
 	FieldID,
 	FieldType,
 	FieldName,
+	FieldRating,
+	FieldDefenderRating,
+	FieldAttackerRating,
+	FieldSemoRating,
+	FieldExperience,
+	FieldStarterGranted,
+	FieldSecret,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the Player type.
@@ -58,4 +115,18 @@ var (
 	TypeValidator func(int) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultRating holds the default value on creation for the "rating" field.
+	DefaultRating int
+	// DefaultDefenderRating holds the default value on creation for the "defender_rating" field.
+	DefaultDefenderRating int
+	// DefaultAttackerRating holds the default value on creation for the "attacker_rating" field.
+	DefaultAttackerRating int
+	// DefaultSemoRating holds the default value on creation for the "semo_rating" field.
+	DefaultSemoRating int
+	// DefaultExperience holds the default value on creation for the "experience" field.
+	DefaultExperience int
+	// ExperienceValidator is a validator for the "experience" field. It is called by the builders before save.
+	ExperienceValidator func(int) error
+	// DefaultStarterGranted holds the default value on creation for the "starter_granted" field.
+	DefaultStarterGranted bool
 )
@@ -3,8 +3,12 @@
 package ent
 
 import (
+	"app/ent/deck"
 	"app/ent/game"
+	"app/ent/ownedcard"
+	"app/ent/participant"
 	"app/ent/player"
+	"app/ent/ratingchange"
 	"context"
 	"errors"
 	"fmt"
@@ -32,6 +36,104 @@ func (pc *PlayerCreate) SetName(s string) *PlayerCreate {
 	return pc
 }
 
+// SetRating sets the "rating" field.
+func (pc *PlayerCreate) SetRating(i int) *PlayerCreate {
+	pc.mutation.SetRating(i)
+	return pc
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableRating(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetRating(*i)
+	}
+	return pc
+}
+
+// SetDefenderRating sets the "defender_rating" field.
+func (pc *PlayerCreate) SetDefenderRating(i int) *PlayerCreate {
+	pc.mutation.SetDefenderRating(i)
+	return pc
+}
+
+// SetNillableDefenderRating sets the "defender_rating" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableDefenderRating(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetDefenderRating(*i)
+	}
+	return pc
+}
+
+// SetAttackerRating sets the "attacker_rating" field.
+func (pc *PlayerCreate) SetAttackerRating(i int) *PlayerCreate {
+	pc.mutation.SetAttackerRating(i)
+	return pc
+}
+
+// SetNillableAttackerRating sets the "attacker_rating" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableAttackerRating(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetAttackerRating(*i)
+	}
+	return pc
+}
+
+// SetSemoRating sets the "semo_rating" field.
+func (pc *PlayerCreate) SetSemoRating(i int) *PlayerCreate {
+	pc.mutation.SetSemoRating(i)
+	return pc
+}
+
+// SetNillableSemoRating sets the "semo_rating" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableSemoRating(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetSemoRating(*i)
+	}
+	return pc
+}
+
+// SetExperience sets the "experience" field.
+func (pc *PlayerCreate) SetExperience(i int) *PlayerCreate {
+	pc.mutation.SetExperience(i)
+	return pc
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableExperience(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetExperience(*i)
+	}
+	return pc
+}
+
+// SetStarterGranted sets the "starter_granted" field.
+func (pc *PlayerCreate) SetStarterGranted(b bool) *PlayerCreate {
+	pc.mutation.SetStarterGranted(b)
+	return pc
+}
+
+// SetNillableStarterGranted sets the "starter_granted" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableStarterGranted(b *bool) *PlayerCreate {
+	if b != nil {
+		pc.SetStarterGranted(*b)
+	}
+	return pc
+}
+
+// SetSecret sets the "secret" field.
+func (pc *PlayerCreate) SetSecret(s string) *PlayerCreate {
+	pc.mutation.SetSecret(s)
+	return pc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableSecret(s *string) *PlayerCreate {
+	if s != nil {
+		pc.SetSecret(*s)
+	}
+	return pc
+}
+
 // SetGameID sets the "game" edge to the Game entity by ID.
 func (pc *PlayerCreate) SetGameID(id int) *PlayerCreate {
 	pc.mutation.SetGameID(id)
@@ -51,6 +153,66 @@ func (pc *PlayerCreate) SetGame(g *Game) *PlayerCreate {
 	return pc.SetGameID(g.ID)
 }
 
+// AddRatingIDs adds the "ratings" edge to the RatingChange entity by IDs.
+func (pc *PlayerCreate) AddRatingIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddRatingIDs(ids...)
+	return pc
+}
+
+// AddRatings adds the "ratings" edges to the RatingChange entity.
+func (pc *PlayerCreate) AddRatings(r ...*RatingChange) *PlayerCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pc.AddRatingIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participant entity by IDs.
+func (pc *PlayerCreate) AddParticipationIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddParticipationIDs(ids...)
+	return pc
+}
+
+// AddParticipations adds the "participations" edges to the Participant entity.
+func (pc *PlayerCreate) AddParticipations(p ...*Participant) *PlayerCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddParticipationIDs(ids...)
+}
+
+// AddDeckIDs adds the "decks" edge to the Deck entity by IDs.
+func (pc *PlayerCreate) AddDeckIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddDeckIDs(ids...)
+	return pc
+}
+
+// AddDecks adds the "decks" edges to the Deck entity.
+func (pc *PlayerCreate) AddDecks(d ...*Deck) *PlayerCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pc.AddDeckIDs(ids...)
+}
+
+// AddCardIDs adds the "cards" edge to the OwnedCard entity by IDs.
+func (pc *PlayerCreate) AddCardIDs(ids ...int) *PlayerCreate {
+	pc.mutation.AddCardIDs(ids...)
+	return pc
+}
+
+// AddCards adds the "cards" edges to the OwnedCard entity.
+func (pc *PlayerCreate) AddCards(o ...*OwnedCard) *PlayerCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pc.AddCardIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (pc *PlayerCreate) Mutation() *PlayerMutation {
 	return pc.mutation
@@ -62,6 +224,7 @@ func (pc *PlayerCreate) Save(ctx context.Context) (*Player, error) {
 		err  error
 		node *Player
 	)
+	pc.defaults()
 	if len(pc.hooks) == 0 {
 		if err = pc.check(); err != nil {
 			return nil, err
@@ -100,6 +263,34 @@ func (pc *PlayerCreate) SaveX(ctx context.Context) *Player {
 	return v
 }
 
+// defaults sets the default values of the builder before save.
+func (pc *PlayerCreate) defaults() {
+	if _, ok := pc.mutation.Rating(); !ok {
+		v := player.DefaultRating
+		pc.mutation.SetRating(v)
+	}
+	if _, ok := pc.mutation.DefenderRating(); !ok {
+		v := player.DefaultDefenderRating
+		pc.mutation.SetDefenderRating(v)
+	}
+	if _, ok := pc.mutation.AttackerRating(); !ok {
+		v := player.DefaultAttackerRating
+		pc.mutation.SetAttackerRating(v)
+	}
+	if _, ok := pc.mutation.SemoRating(); !ok {
+		v := player.DefaultSemoRating
+		pc.mutation.SetSemoRating(v)
+	}
+	if _, ok := pc.mutation.Experience(); !ok {
+		v := player.DefaultExperience
+		pc.mutation.SetExperience(v)
+	}
+	if _, ok := pc.mutation.StarterGranted(); !ok {
+		v := player.DefaultStarterGranted
+		pc.mutation.SetStarterGranted(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (pc *PlayerCreate) check() error {
 	if _, ok := pc.mutation.GetType(); !ok {
@@ -118,6 +309,29 @@ func (pc *PlayerCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %w", err)}
 		}
 	}
+	if _, ok := pc.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New("ent: missing required field \"rating\"")}
+	}
+	if _, ok := pc.mutation.DefenderRating(); !ok {
+		return &ValidationError{Name: "defender_rating", err: errors.New("ent: missing required field \"defender_rating\"")}
+	}
+	if _, ok := pc.mutation.AttackerRating(); !ok {
+		return &ValidationError{Name: "attacker_rating", err: errors.New("ent: missing required field \"attacker_rating\"")}
+	}
+	if _, ok := pc.mutation.SemoRating(); !ok {
+		return &ValidationError{Name: "semo_rating", err: errors.New("ent: missing required field \"semo_rating\"")}
+	}
+	if _, ok := pc.mutation.Experience(); !ok {
+		return &ValidationError{Name: "experience", err: errors.New("ent: missing required field \"experience\"")}
+	}
+	if v, ok := pc.mutation.Experience(); ok {
+		if err := player.ExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "experience", err: fmt.Errorf("ent: validator failed for field \"experience\": %w", err)}
+		}
+	}
+	if _, ok := pc.mutation.StarterGranted(); !ok {
+		return &ValidationError{Name: "starter_granted", err: errors.New("ent: missing required field \"starter_granted\"")}
+	}
 	return nil
 }
 
@@ -161,6 +375,62 @@ func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		})
 		_node.Name = value
 	}
+	if value, ok := pc.mutation.Rating(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldRating,
+		})
+		_node.Rating = value
+	}
+	if value, ok := pc.mutation.DefenderRating(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldDefenderRating,
+		})
+		_node.DefenderRating = value
+	}
+	if value, ok := pc.mutation.AttackerRating(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldAttackerRating,
+		})
+		_node.AttackerRating = value
+	}
+	if value, ok := pc.mutation.SemoRating(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldSemoRating,
+		})
+		_node.SemoRating = value
+	}
+	if value, ok := pc.mutation.Experience(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldExperience,
+		})
+		_node.Experience = value
+	}
+	if value, ok := pc.mutation.StarterGranted(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: player.FieldStarterGranted,
+		})
+		_node.StarterGranted = value
+	}
+	if value, ok := pc.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldSecret,
+		})
+		_node.Secret = value
+	}
 	if nodes := pc.mutation.GameIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -180,6 +450,82 @@ func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.DecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.CardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -197,6 +543,7 @@ func (pcb *PlayerCreateBulk) Save(ctx context.Context) ([]*Player, error) {
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PlayerMutation)
 				if !ok {
@@ -3,10 +3,15 @@
 package ent
 
 import (
+	"app/ent/deck"
 	"app/ent/game"
+	"app/ent/ownedcard"
+	"app/ent/participant"
 	"app/ent/player"
 	"app/ent/predicate"
+	"app/ent/ratingchange"
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -25,8 +30,12 @@ type PlayerQuery struct {
 	fields     []string
 	predicates []predicate.Player
 	// eager-loading edges.
-	withGame *GameQuery
-	withFKs  bool
+	withGame           *GameQuery
+	withRatings        *RatingChangeQuery
+	withParticipations *ParticipantQuery
+	withDecks          *DeckQuery
+	withCards          *OwnedCardQuery
+	withFKs            bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -78,6 +87,94 @@ func (pq *PlayerQuery) QueryGame() *GameQuery {
 	return query
 }
 
+// QueryRatings chains the current query on the "ratings" edge.
+func (pq *PlayerQuery) QueryRatings() *RatingChangeQuery {
+	query := &RatingChangeQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(ratingchange.Table, ratingchange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.RatingsTable, player.RatingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParticipations chains the current query on the "participations" edge.
+func (pq *PlayerQuery) QueryParticipations() *ParticipantQuery {
+	query := &ParticipantQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(participant.Table, participant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.ParticipationsTable, player.ParticipationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDecks chains the current query on the "decks" edge.
+func (pq *PlayerQuery) QueryDecks() *DeckQuery {
+	query := &DeckQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.DecksTable, player.DecksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCards chains the current query on the "cards" edge.
+func (pq *PlayerQuery) QueryCards() *OwnedCardQuery {
+	query := &OwnedCardQuery{config: pq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, selector),
+			sqlgraph.To(ownedcard.Table, ownedcard.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, player.CardsTable, player.CardsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Player entity from the query.
 // Returns a *NotFoundError when no Player was found.
 func (pq *PlayerQuery) First(ctx context.Context) (*Player, error) {
@@ -254,12 +351,16 @@ func (pq *PlayerQuery) Clone() *PlayerQuery {
 		return nil
 	}
 	return &PlayerQuery{
-		config:     pq.config,
-		limit:      pq.limit,
-		offset:     pq.offset,
-		order:      append([]OrderFunc{}, pq.order...),
-		predicates: append([]predicate.Player{}, pq.predicates...),
-		withGame:   pq.withGame.Clone(),
+		config:             pq.config,
+		limit:              pq.limit,
+		offset:             pq.offset,
+		order:              append([]OrderFunc{}, pq.order...),
+		predicates:         append([]predicate.Player{}, pq.predicates...),
+		withGame:           pq.withGame.Clone(),
+		withRatings:        pq.withRatings.Clone(),
+		withParticipations: pq.withParticipations.Clone(),
+		withDecks:          pq.withDecks.Clone(),
+		withCards:          pq.withCards.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -277,6 +378,50 @@ func (pq *PlayerQuery) WithGame(opts ...func(*GameQuery)) *PlayerQuery {
 	return pq
 }
 
+// WithRatings tells the query-builder to eager-load the nodes that are connected to
+// the "ratings" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithRatings(opts ...func(*RatingChangeQuery)) *PlayerQuery {
+	query := &RatingChangeQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withRatings = query
+	return pq
+}
+
+// WithParticipations tells the query-builder to eager-load the nodes that are connected to
+// the "participations" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithParticipations(opts ...func(*ParticipantQuery)) *PlayerQuery {
+	query := &ParticipantQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withParticipations = query
+	return pq
+}
+
+// WithDecks tells the query-builder to eager-load the nodes that are connected to
+// the "decks" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithDecks(opts ...func(*DeckQuery)) *PlayerQuery {
+	query := &DeckQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withDecks = query
+	return pq
+}
+
+// WithCards tells the query-builder to eager-load the nodes that are connected to
+// the "cards" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *PlayerQuery) WithCards(opts ...func(*OwnedCardQuery)) *PlayerQuery {
+	query := &OwnedCardQuery{config: pq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withCards = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -343,8 +488,12 @@ func (pq *PlayerQuery) sqlAll(ctx context.Context) ([]*Player, error) {
 		nodes       = []*Player{}
 		withFKs     = pq.withFKs
 		_spec       = pq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [5]bool{
 			pq.withGame != nil,
+			pq.withRatings != nil,
+			pq.withParticipations != nil,
+			pq.withDecks != nil,
+			pq.withCards != nil,
 		}
 	)
 	if pq.withGame != nil {
@@ -398,6 +547,122 @@ func (pq *PlayerQuery) sqlAll(ctx context.Context) ([]*Player, error) {
 		}
 	}
 
+	if query := pq.withRatings; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Player)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Ratings = []*RatingChange{}
+		}
+		query.withFKs = true
+		query.Where(predicate.RatingChange(func(s *sql.Selector) {
+			s.Where(sql.InValues(player.RatingsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.player_ratings
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "player_ratings" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_ratings" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Ratings = append(node.Edges.Ratings, n)
+		}
+	}
+
+	if query := pq.withParticipations; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Player)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Participations = []*Participant{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Participant(func(s *sql.Selector) {
+			s.Where(sql.InValues(player.ParticipationsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.player_participations
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "player_participations" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_participations" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Participations = append(node.Edges.Participations, n)
+		}
+	}
+
+	if query := pq.withDecks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Player)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Decks = []*Deck{}
+		}
+		query.withFKs = true
+		query.Where(predicate.Deck(func(s *sql.Selector) {
+			s.Where(sql.InValues(player.DecksColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.player_decks
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "player_decks" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_decks" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Decks = append(node.Edges.Decks, n)
+		}
+	}
+
+	if query := pq.withCards; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Player)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+			nodes[i].Edges.Cards = []*OwnedCard{}
+		}
+		query.withFKs = true
+		query.Where(predicate.OwnedCard(func(s *sql.Selector) {
+			s.Where(sql.InValues(player.CardsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.player_cards
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "player_cards" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_cards" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Cards = append(node.Edges.Cards, n)
+		}
+	}
+
 	return nodes, nil
 }
 
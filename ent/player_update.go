@@ -3,9 +3,13 @@
 package ent
 
 import (
+	"app/ent/deck"
 	"app/ent/game"
+	"app/ent/ownedcard"
+	"app/ent/participant"
 	"app/ent/player"
 	"app/ent/predicate"
+	"app/ent/ratingchange"
 	"context"
 	"fmt"
 
@@ -40,6 +44,145 @@ func (pu *PlayerUpdate) AddType(i int) *PlayerUpdate {
 	return pu
 }
 
+// SetRating sets the "rating" field.
+func (pu *PlayerUpdate) SetRating(i int) *PlayerUpdate {
+	pu.mutation.ResetRating()
+	pu.mutation.SetRating(i)
+	return pu
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableRating(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetRating(*i)
+	}
+	return pu
+}
+
+// AddRating adds i to the "rating" field.
+func (pu *PlayerUpdate) AddRating(i int) *PlayerUpdate {
+	pu.mutation.AddRating(i)
+	return pu
+}
+
+// SetDefenderRating sets the "defender_rating" field.
+func (pu *PlayerUpdate) SetDefenderRating(i int) *PlayerUpdate {
+	pu.mutation.ResetDefenderRating()
+	pu.mutation.SetDefenderRating(i)
+	return pu
+}
+
+// SetNillableDefenderRating sets the "defender_rating" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableDefenderRating(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetDefenderRating(*i)
+	}
+	return pu
+}
+
+// AddDefenderRating adds i to the "defender_rating" field.
+func (pu *PlayerUpdate) AddDefenderRating(i int) *PlayerUpdate {
+	pu.mutation.AddDefenderRating(i)
+	return pu
+}
+
+// SetAttackerRating sets the "attacker_rating" field.
+func (pu *PlayerUpdate) SetAttackerRating(i int) *PlayerUpdate {
+	pu.mutation.ResetAttackerRating()
+	pu.mutation.SetAttackerRating(i)
+	return pu
+}
+
+// SetNillableAttackerRating sets the "attacker_rating" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableAttackerRating(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetAttackerRating(*i)
+	}
+	return pu
+}
+
+// AddAttackerRating adds i to the "attacker_rating" field.
+func (pu *PlayerUpdate) AddAttackerRating(i int) *PlayerUpdate {
+	pu.mutation.AddAttackerRating(i)
+	return pu
+}
+
+// SetSemoRating sets the "semo_rating" field.
+func (pu *PlayerUpdate) SetSemoRating(i int) *PlayerUpdate {
+	pu.mutation.ResetSemoRating()
+	pu.mutation.SetSemoRating(i)
+	return pu
+}
+
+// SetNillableSemoRating sets the "semo_rating" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableSemoRating(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetSemoRating(*i)
+	}
+	return pu
+}
+
+// AddSemoRating adds i to the "semo_rating" field.
+func (pu *PlayerUpdate) AddSemoRating(i int) *PlayerUpdate {
+	pu.mutation.AddSemoRating(i)
+	return pu
+}
+
+// SetExperience sets the "experience" field.
+func (pu *PlayerUpdate) SetExperience(i int) *PlayerUpdate {
+	pu.mutation.ResetExperience()
+	pu.mutation.SetExperience(i)
+	return pu
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableExperience(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetExperience(*i)
+	}
+	return pu
+}
+
+// AddExperience adds i to the "experience" field.
+func (pu *PlayerUpdate) AddExperience(i int) *PlayerUpdate {
+	pu.mutation.AddExperience(i)
+	return pu
+}
+
+// SetStarterGranted sets the "starter_granted" field.
+func (pu *PlayerUpdate) SetStarterGranted(b bool) *PlayerUpdate {
+	pu.mutation.SetStarterGranted(b)
+	return pu
+}
+
+// SetNillableStarterGranted sets the "starter_granted" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableStarterGranted(b *bool) *PlayerUpdate {
+	if b != nil {
+		pu.SetStarterGranted(*b)
+	}
+	return pu
+}
+
+// SetSecret sets the "secret" field.
+func (pu *PlayerUpdate) SetSecret(s string) *PlayerUpdate {
+	pu.mutation.SetSecret(s)
+	return pu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableSecret(s *string) *PlayerUpdate {
+	if s != nil {
+		pu.SetSecret(*s)
+	}
+	return pu
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (pu *PlayerUpdate) ClearSecret() *PlayerUpdate {
+	pu.mutation.ClearSecret()
+	return pu
+}
+
 // SetGameID sets the "game" edge to the Game entity by ID.
 func (pu *PlayerUpdate) SetGameID(id int) *PlayerUpdate {
 	pu.mutation.SetGameID(id)
@@ -59,6 +202,66 @@ func (pu *PlayerUpdate) SetGame(g *Game) *PlayerUpdate {
 	return pu.SetGameID(g.ID)
 }
 
+// AddRatingIDs adds the "ratings" edge to the RatingChange entity by IDs.
+func (pu *PlayerUpdate) AddRatingIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddRatingIDs(ids...)
+	return pu
+}
+
+// AddRatings adds the "ratings" edges to the RatingChange entity.
+func (pu *PlayerUpdate) AddRatings(r ...*RatingChange) *PlayerUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.AddRatingIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participant entity by IDs.
+func (pu *PlayerUpdate) AddParticipationIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddParticipationIDs(ids...)
+	return pu
+}
+
+// AddParticipations adds the "participations" edges to the Participant entity.
+func (pu *PlayerUpdate) AddParticipations(p ...*Participant) *PlayerUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddParticipationIDs(ids...)
+}
+
+// AddDeckIDs adds the "decks" edge to the Deck entity by IDs.
+func (pu *PlayerUpdate) AddDeckIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddDeckIDs(ids...)
+	return pu
+}
+
+// AddDecks adds the "decks" edges to the Deck entity.
+func (pu *PlayerUpdate) AddDecks(d ...*Deck) *PlayerUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.AddDeckIDs(ids...)
+}
+
+// AddCardIDs adds the "cards" edge to the OwnedCard entity by IDs.
+func (pu *PlayerUpdate) AddCardIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.AddCardIDs(ids...)
+	return pu
+}
+
+// AddCards adds the "cards" edges to the OwnedCard entity.
+func (pu *PlayerUpdate) AddCards(o ...*OwnedCard) *PlayerUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.AddCardIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (pu *PlayerUpdate) Mutation() *PlayerMutation {
 	return pu.mutation
@@ -70,6 +273,90 @@ func (pu *PlayerUpdate) ClearGame() *PlayerUpdate {
 	return pu
 }
 
+// ClearRatings clears all "ratings" edges to the RatingChange entity.
+func (pu *PlayerUpdate) ClearRatings() *PlayerUpdate {
+	pu.mutation.ClearRatings()
+	return pu
+}
+
+// RemoveRatingIDs removes the "ratings" edge to RatingChange entities by IDs.
+func (pu *PlayerUpdate) RemoveRatingIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveRatingIDs(ids...)
+	return pu
+}
+
+// RemoveRatings removes "ratings" edges to RatingChange entities.
+func (pu *PlayerUpdate) RemoveRatings(r ...*RatingChange) *PlayerUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return pu.RemoveRatingIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participant entity.
+func (pu *PlayerUpdate) ClearParticipations() *PlayerUpdate {
+	pu.mutation.ClearParticipations()
+	return pu
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participant entities by IDs.
+func (pu *PlayerUpdate) RemoveParticipationIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveParticipationIDs(ids...)
+	return pu
+}
+
+// RemoveParticipations removes "participations" edges to Participant entities.
+func (pu *PlayerUpdate) RemoveParticipations(p ...*Participant) *PlayerUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveParticipationIDs(ids...)
+}
+
+// ClearDecks clears all "decks" edges to the Deck entity.
+func (pu *PlayerUpdate) ClearDecks() *PlayerUpdate {
+	pu.mutation.ClearDecks()
+	return pu
+}
+
+// RemoveDeckIDs removes the "decks" edge to Deck entities by IDs.
+func (pu *PlayerUpdate) RemoveDeckIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveDeckIDs(ids...)
+	return pu
+}
+
+// RemoveDecks removes "decks" edges to Deck entities.
+func (pu *PlayerUpdate) RemoveDecks(d ...*Deck) *PlayerUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return pu.RemoveDeckIDs(ids...)
+}
+
+// ClearCards clears all "cards" edges to the OwnedCard entity.
+func (pu *PlayerUpdate) ClearCards() *PlayerUpdate {
+	pu.mutation.ClearCards()
+	return pu
+}
+
+// RemoveCardIDs removes the "cards" edge to OwnedCard entities by IDs.
+func (pu *PlayerUpdate) RemoveCardIDs(ids ...int) *PlayerUpdate {
+	pu.mutation.RemoveCardIDs(ids...)
+	return pu
+}
+
+// RemoveCards removes "cards" edges to OwnedCard entities.
+func (pu *PlayerUpdate) RemoveCards(o ...*OwnedCard) *PlayerUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pu.RemoveCardIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PlayerUpdate) Save(ctx context.Context) (int, error) {
 	var (
@@ -134,6 +421,11 @@ func (pu *PlayerUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %w", err)}
 		}
 	}
+	if v, ok := pu.mutation.Experience(); ok {
+		if err := player.ExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "experience", err: fmt.Errorf("ent: validator failed for field \"experience\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -169,6 +461,96 @@ func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: player.FieldType,
 		})
 	}
+	if value, ok := pu.mutation.Rating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldRating,
+		})
+	}
+	if value, ok := pu.mutation.AddedRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldRating,
+		})
+	}
+	if value, ok := pu.mutation.DefenderRating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldDefenderRating,
+		})
+	}
+	if value, ok := pu.mutation.AddedDefenderRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldDefenderRating,
+		})
+	}
+	if value, ok := pu.mutation.AttackerRating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldAttackerRating,
+		})
+	}
+	if value, ok := pu.mutation.AddedAttackerRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldAttackerRating,
+		})
+	}
+	if value, ok := pu.mutation.SemoRating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldSemoRating,
+		})
+	}
+	if value, ok := pu.mutation.AddedSemoRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldSemoRating,
+		})
+	}
+	if value, ok := pu.mutation.Experience(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldExperience,
+		})
+	}
+	if value, ok := pu.mutation.AddedExperience(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldExperience,
+		})
+	}
+	if value, ok := pu.mutation.StarterGranted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: player.FieldStarterGranted,
+		})
+	}
+	if value, ok := pu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldSecret,
+		})
+	}
+	if pu.mutation.SecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: player.FieldSecret,
+		})
+	}
 	if pu.mutation.GameCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -204,56 +586,471 @@ func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{player.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	if pu.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return n, nil
-}
-
-// PlayerUpdateOne is the builder for updating a single Player entity.
-type PlayerUpdateOne struct {
-	config
-	hooks    []Hook
-	mutation *PlayerMutation
-}
-
-// SetType sets the "type" field.
-func (puo *PlayerUpdateOne) SetType(i int) *PlayerUpdateOne {
-	puo.mutation.ResetType()
-	puo.mutation.SetType(i)
-	return puo
-}
-
-// AddType adds i to the "type" field.
-func (puo *PlayerUpdateOne) AddType(i int) *PlayerUpdateOne {
-	puo.mutation.AddType(i)
-	return puo
-}
-
-// SetGameID sets the "game" edge to the Game entity by ID.
-func (puo *PlayerUpdateOne) SetGameID(id int) *PlayerUpdateOne {
-	puo.mutation.SetGameID(id)
-	return puo
-}
-
-// SetNillableGameID sets the "game" edge to the Game entity by ID if the given value is not nil.
-func (puo *PlayerUpdateOne) SetNillableGameID(id *int) *PlayerUpdateOne {
-	if id != nil {
-		puo = puo.SetGameID(*id)
+	if nodes := pu.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !pu.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return puo
-}
-
-// SetGame sets the "game" edge to the Game entity.
-func (puo *PlayerUpdateOne) SetGame(g *Game) *PlayerUpdateOne {
-	return puo.SetGameID(g.ID)
-}
-
+	if nodes := pu.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !pu.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.DecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedDecksIDs(); len(nodes) > 0 && !pu.mutation.DecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.DecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.CardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCardsIDs(); len(nodes) > 0 && !pu.mutation.CardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{player.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// PlayerUpdateOne is the builder for updating a single Player entity.
+type PlayerUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *PlayerMutation
+}
+
+// SetType sets the "type" field.
+func (puo *PlayerUpdateOne) SetType(i int) *PlayerUpdateOne {
+	puo.mutation.ResetType()
+	puo.mutation.SetType(i)
+	return puo
+}
+
+// AddType adds i to the "type" field.
+func (puo *PlayerUpdateOne) AddType(i int) *PlayerUpdateOne {
+	puo.mutation.AddType(i)
+	return puo
+}
+
+// SetRating sets the "rating" field.
+func (puo *PlayerUpdateOne) SetRating(i int) *PlayerUpdateOne {
+	puo.mutation.ResetRating()
+	puo.mutation.SetRating(i)
+	return puo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableRating(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetRating(*i)
+	}
+	return puo
+}
+
+// AddRating adds i to the "rating" field.
+func (puo *PlayerUpdateOne) AddRating(i int) *PlayerUpdateOne {
+	puo.mutation.AddRating(i)
+	return puo
+}
+
+// SetDefenderRating sets the "defender_rating" field.
+func (puo *PlayerUpdateOne) SetDefenderRating(i int) *PlayerUpdateOne {
+	puo.mutation.ResetDefenderRating()
+	puo.mutation.SetDefenderRating(i)
+	return puo
+}
+
+// SetNillableDefenderRating sets the "defender_rating" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableDefenderRating(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetDefenderRating(*i)
+	}
+	return puo
+}
+
+// AddDefenderRating adds i to the "defender_rating" field.
+func (puo *PlayerUpdateOne) AddDefenderRating(i int) *PlayerUpdateOne {
+	puo.mutation.AddDefenderRating(i)
+	return puo
+}
+
+// SetAttackerRating sets the "attacker_rating" field.
+func (puo *PlayerUpdateOne) SetAttackerRating(i int) *PlayerUpdateOne {
+	puo.mutation.ResetAttackerRating()
+	puo.mutation.SetAttackerRating(i)
+	return puo
+}
+
+// SetNillableAttackerRating sets the "attacker_rating" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableAttackerRating(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetAttackerRating(*i)
+	}
+	return puo
+}
+
+// AddAttackerRating adds i to the "attacker_rating" field.
+func (puo *PlayerUpdateOne) AddAttackerRating(i int) *PlayerUpdateOne {
+	puo.mutation.AddAttackerRating(i)
+	return puo
+}
+
+// SetSemoRating sets the "semo_rating" field.
+func (puo *PlayerUpdateOne) SetSemoRating(i int) *PlayerUpdateOne {
+	puo.mutation.ResetSemoRating()
+	puo.mutation.SetSemoRating(i)
+	return puo
+}
+
+// SetNillableSemoRating sets the "semo_rating" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableSemoRating(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetSemoRating(*i)
+	}
+	return puo
+}
+
+// AddSemoRating adds i to the "semo_rating" field.
+func (puo *PlayerUpdateOne) AddSemoRating(i int) *PlayerUpdateOne {
+	puo.mutation.AddSemoRating(i)
+	return puo
+}
+
+// SetExperience sets the "experience" field.
+func (puo *PlayerUpdateOne) SetExperience(i int) *PlayerUpdateOne {
+	puo.mutation.ResetExperience()
+	puo.mutation.SetExperience(i)
+	return puo
+}
+
+// SetNillableExperience sets the "experience" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableExperience(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetExperience(*i)
+	}
+	return puo
+}
+
+// AddExperience adds i to the "experience" field.
+func (puo *PlayerUpdateOne) AddExperience(i int) *PlayerUpdateOne {
+	puo.mutation.AddExperience(i)
+	return puo
+}
+
+// SetStarterGranted sets the "starter_granted" field.
+func (puo *PlayerUpdateOne) SetStarterGranted(b bool) *PlayerUpdateOne {
+	puo.mutation.SetStarterGranted(b)
+	return puo
+}
+
+// SetNillableStarterGranted sets the "starter_granted" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableStarterGranted(b *bool) *PlayerUpdateOne {
+	if b != nil {
+		puo.SetStarterGranted(*b)
+	}
+	return puo
+}
+
+// SetSecret sets the "secret" field.
+func (puo *PlayerUpdateOne) SetSecret(s string) *PlayerUpdateOne {
+	puo.mutation.SetSecret(s)
+	return puo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableSecret(s *string) *PlayerUpdateOne {
+	if s != nil {
+		puo.SetSecret(*s)
+	}
+	return puo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (puo *PlayerUpdateOne) ClearSecret() *PlayerUpdateOne {
+	puo.mutation.ClearSecret()
+	return puo
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (puo *PlayerUpdateOne) SetGameID(id int) *PlayerUpdateOne {
+	puo.mutation.SetGameID(id)
+	return puo
+}
+
+// SetNillableGameID sets the "game" edge to the Game entity by ID if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableGameID(id *int) *PlayerUpdateOne {
+	if id != nil {
+		puo = puo.SetGameID(*id)
+	}
+	return puo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (puo *PlayerUpdateOne) SetGame(g *Game) *PlayerUpdateOne {
+	return puo.SetGameID(g.ID)
+}
+
+// AddRatingIDs adds the "ratings" edge to the RatingChange entity by IDs.
+func (puo *PlayerUpdateOne) AddRatingIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddRatingIDs(ids...)
+	return puo
+}
+
+// AddRatings adds the "ratings" edges to the RatingChange entity.
+func (puo *PlayerUpdateOne) AddRatings(r ...*RatingChange) *PlayerUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.AddRatingIDs(ids...)
+}
+
+// AddParticipationIDs adds the "participations" edge to the Participant entity by IDs.
+func (puo *PlayerUpdateOne) AddParticipationIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddParticipationIDs(ids...)
+	return puo
+}
+
+// AddParticipations adds the "participations" edges to the Participant entity.
+func (puo *PlayerUpdateOne) AddParticipations(p ...*Participant) *PlayerUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddParticipationIDs(ids...)
+}
+
+// AddDeckIDs adds the "decks" edge to the Deck entity by IDs.
+func (puo *PlayerUpdateOne) AddDeckIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddDeckIDs(ids...)
+	return puo
+}
+
+// AddDecks adds the "decks" edges to the Deck entity.
+func (puo *PlayerUpdateOne) AddDecks(d ...*Deck) *PlayerUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.AddDeckIDs(ids...)
+}
+
+// AddCardIDs adds the "cards" edge to the OwnedCard entity by IDs.
+func (puo *PlayerUpdateOne) AddCardIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.AddCardIDs(ids...)
+	return puo
+}
+
+// AddCards adds the "cards" edges to the OwnedCard entity.
+func (puo *PlayerUpdateOne) AddCards(o ...*OwnedCard) *PlayerUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.AddCardIDs(ids...)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (puo *PlayerUpdateOne) Mutation() *PlayerMutation {
 	return puo.mutation
@@ -265,6 +1062,90 @@ func (puo *PlayerUpdateOne) ClearGame() *PlayerUpdateOne {
 	return puo
 }
 
+// ClearRatings clears all "ratings" edges to the RatingChange entity.
+func (puo *PlayerUpdateOne) ClearRatings() *PlayerUpdateOne {
+	puo.mutation.ClearRatings()
+	return puo
+}
+
+// RemoveRatingIDs removes the "ratings" edge to RatingChange entities by IDs.
+func (puo *PlayerUpdateOne) RemoveRatingIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveRatingIDs(ids...)
+	return puo
+}
+
+// RemoveRatings removes "ratings" edges to RatingChange entities.
+func (puo *PlayerUpdateOne) RemoveRatings(r ...*RatingChange) *PlayerUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return puo.RemoveRatingIDs(ids...)
+}
+
+// ClearParticipations clears all "participations" edges to the Participant entity.
+func (puo *PlayerUpdateOne) ClearParticipations() *PlayerUpdateOne {
+	puo.mutation.ClearParticipations()
+	return puo
+}
+
+// RemoveParticipationIDs removes the "participations" edge to Participant entities by IDs.
+func (puo *PlayerUpdateOne) RemoveParticipationIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveParticipationIDs(ids...)
+	return puo
+}
+
+// RemoveParticipations removes "participations" edges to Participant entities.
+func (puo *PlayerUpdateOne) RemoveParticipations(p ...*Participant) *PlayerUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveParticipationIDs(ids...)
+}
+
+// ClearDecks clears all "decks" edges to the Deck entity.
+func (puo *PlayerUpdateOne) ClearDecks() *PlayerUpdateOne {
+	puo.mutation.ClearDecks()
+	return puo
+}
+
+// RemoveDeckIDs removes the "decks" edge to Deck entities by IDs.
+func (puo *PlayerUpdateOne) RemoveDeckIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveDeckIDs(ids...)
+	return puo
+}
+
+// RemoveDecks removes "decks" edges to Deck entities.
+func (puo *PlayerUpdateOne) RemoveDecks(d ...*Deck) *PlayerUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return puo.RemoveDeckIDs(ids...)
+}
+
+// ClearCards clears all "cards" edges to the OwnedCard entity.
+func (puo *PlayerUpdateOne) ClearCards() *PlayerUpdateOne {
+	puo.mutation.ClearCards()
+	return puo
+}
+
+// RemoveCardIDs removes the "cards" edge to OwnedCard entities by IDs.
+func (puo *PlayerUpdateOne) RemoveCardIDs(ids ...int) *PlayerUpdateOne {
+	puo.mutation.RemoveCardIDs(ids...)
+	return puo
+}
+
+// RemoveCards removes "cards" edges to OwnedCard entities.
+func (puo *PlayerUpdateOne) RemoveCards(o ...*OwnedCard) *PlayerUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return puo.RemoveCardIDs(ids...)
+}
+
 // Save executes the query and returns the updated Player entity.
 func (puo *PlayerUpdateOne) Save(ctx context.Context) (*Player, error) {
 	var (
@@ -329,6 +1210,11 @@ func (puo *PlayerUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %w", err)}
 		}
 	}
+	if v, ok := puo.mutation.Experience(); ok {
+		if err := player.ExperienceValidator(v); err != nil {
+			return &ValidationError{Name: "experience", err: fmt.Errorf("ent: validator failed for field \"experience\": %w", err)}
+		}
+	}
 	return nil
 }
 
@@ -369,6 +1255,96 @@ func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err err
 			Column: player.FieldType,
 		})
 	}
+	if value, ok := puo.mutation.Rating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldRating,
+		})
+	}
+	if value, ok := puo.mutation.AddedRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldRating,
+		})
+	}
+	if value, ok := puo.mutation.DefenderRating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldDefenderRating,
+		})
+	}
+	if value, ok := puo.mutation.AddedDefenderRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldDefenderRating,
+		})
+	}
+	if value, ok := puo.mutation.AttackerRating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldAttackerRating,
+		})
+	}
+	if value, ok := puo.mutation.AddedAttackerRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldAttackerRating,
+		})
+	}
+	if value, ok := puo.mutation.SemoRating(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldSemoRating,
+		})
+	}
+	if value, ok := puo.mutation.AddedSemoRating(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldSemoRating,
+		})
+	}
+	if value, ok := puo.mutation.Experience(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldExperience,
+		})
+	}
+	if value, ok := puo.mutation.AddedExperience(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: player.FieldExperience,
+		})
+	}
+	if value, ok := puo.mutation.StarterGranted(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: player.FieldStarterGranted,
+		})
+	}
+	if value, ok := puo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: player.FieldSecret,
+		})
+	}
+	if puo.mutation.SecretCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: player.FieldSecret,
+		})
+	}
 	if puo.mutation.GameCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -404,6 +1380,222 @@ func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedRatingsIDs(); len(nodes) > 0 && !puo.mutation.RatingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RatingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.RatingsTable,
+			Columns: []string{player.RatingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ratingchange.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedParticipationsIDs(); len(nodes) > 0 && !puo.mutation.ParticipationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ParticipationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.ParticipationsTable,
+			Columns: []string{player.ParticipationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: participant.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.DecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedDecksIDs(); len(nodes) > 0 && !puo.mutation.DecksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.DecksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.DecksTable,
+			Columns: []string{player.DecksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: deck.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.CardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCardsIDs(); len(nodes) > 0 && !puo.mutation.CardsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   player.CardsTable,
+			Columns: []string{player.CardsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: ownedcard.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Player{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,8 +6,20 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Deck is the predicate function for deck builders.
+type Deck func(*sql.Selector)
+
 // Game is the predicate function for game builders.
 type Game func(*sql.Selector)
 
+// OwnedCard is the predicate function for ownedcard builders.
+type OwnedCard func(*sql.Selector)
+
+// Participant is the predicate function for participant builders.
+type Participant func(*sql.Selector)
+
 // Player is the predicate function for player builders.
 type Player func(*sql.Selector)
+
+// RatingChange is the predicate function for ratingchange builders.
+type RatingChange func(*sql.Selector)
@@ -0,0 +1,184 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/player"
+	"app/ent/ratingchange"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// RatingChange is the model entity for the RatingChange schema.
+type RatingChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Role holds the value of the "role" field.
+	Role int `json:"role,omitempty"`
+	// Before holds the value of the "before" field.
+	Before int `json:"before,omitempty"`
+	// After holds the value of the "after" field.
+	After int `json:"after,omitempty"`
+	// Won holds the value of the "won" field.
+	Won bool `json:"won,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RatingChangeQuery when eager-loading is set.
+	Edges          RatingChangeEdges `json:"edges"`
+	player_ratings *int
+}
+
+// RatingChangeEdges holds the relations/edges for other nodes in the graph.
+type RatingChangeEdges struct {
+	// Player holds the value of the player edge.
+	Player *Player `json:"player,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PlayerOrErr returns the Player value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RatingChangeEdges) PlayerOrErr() (*Player, error) {
+	if e.loadedTypes[0] {
+		if e.Player == nil {
+			// The edge player was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: player.Label}
+		}
+		return e.Player, nil
+	}
+	return nil, &NotLoadedError{edge: "player"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RatingChange) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ratingchange.FieldWon:
+			values[i] = &sql.NullBool{}
+		case ratingchange.FieldID, ratingchange.FieldRole, ratingchange.FieldBefore, ratingchange.FieldAfter:
+			values[i] = &sql.NullInt64{}
+		case ratingchange.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		case ratingchange.ForeignKeys[0]: // player_ratings
+			values[i] = &sql.NullInt64{}
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type RatingChange", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RatingChange fields.
+func (rc *RatingChange) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ratingchange.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rc.ID = int(value.Int64)
+		case ratingchange.FieldRole:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				rc.Role = int(value.Int64)
+			}
+		case ratingchange.FieldBefore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value.Valid {
+				rc.Before = int(value.Int64)
+			}
+		case ratingchange.FieldAfter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value.Valid {
+				rc.After = int(value.Int64)
+			}
+		case ratingchange.FieldWon:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field won", values[i])
+			} else if value.Valid {
+				rc.Won = value.Bool
+			}
+		case ratingchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rc.CreatedAt = value.Time
+			}
+		case ratingchange.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field player_ratings", value)
+			} else if value.Valid {
+				rc.player_ratings = new(int)
+				*rc.player_ratings = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// QueryPlayer queries the "player" edge of the RatingChange entity.
+func (rc *RatingChange) QueryPlayer() *PlayerQuery {
+	return (&RatingChangeClient{config: rc.config}).QueryPlayer(rc)
+}
+
+// Update returns a builder for updating this RatingChange.
+// Note that you need to call RatingChange.Unwrap() before calling this method if this RatingChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *RatingChange) Update() *RatingChangeUpdateOne {
+	return (&RatingChangeClient{config: rc.config}).UpdateOne(rc)
+}
+
+// Unwrap unwraps the RatingChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *RatingChange) Unwrap() *RatingChange {
+	tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RatingChange is not a transactional entity")
+	}
+	rc.config.driver = tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *RatingChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("RatingChange(")
+	builder.WriteString(fmt.Sprintf("id=%v", rc.ID))
+	builder.WriteString(", role=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Role))
+	builder.WriteString(", before=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Before))
+	builder.WriteString(", after=")
+	builder.WriteString(fmt.Sprintf("%v", rc.After))
+	builder.WriteString(", won=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Won))
+	builder.WriteString(", created_at=")
+	builder.WriteString(rc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RatingChanges is a parsable slice of RatingChange.
+type RatingChanges []*RatingChange
+
+func (rc RatingChanges) config(cfg config) {
+	for _i := range rc {
+		rc[_i].config = cfg
+	}
+}
@@ -0,0 +1,74 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ratingchange
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the ratingchange type in the database.
+	Label = "rating_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldBefore holds the string denoting the before field in the database.
+	FieldBefore = "before"
+	// FieldAfter holds the string denoting the after field in the database.
+	FieldAfter = "after"
+	// FieldWon holds the string denoting the won field in the database.
+	FieldWon = "won"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgePlayer holds the string denoting the player edge name in mutations.
+	EdgePlayer = "player"
+
+	// Table holds the table name of the ratingchange in the database.
+	Table = "rating_changes"
+	// PlayerTable is the table the holds the player relation/edge.
+	PlayerTable = "rating_changes"
+	// PlayerInverseTable is the table name for the Player entity.
+	// It exists in this package in order to avoid circular dependency with the "player" package.
+	PlayerInverseTable = "players"
+	// PlayerColumn is the table column denoting the player relation/edge.
+	PlayerColumn = "player_ratings"
+)
+
+// Columns holds all SQL columns for ratingchange fields.
+var Columns = []string{
+	FieldID,
+	FieldRole,
+	FieldBefore,
+	FieldAfter,
+	FieldWon,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the RatingChange type.
+var ForeignKeys = []string{
+	"player_ratings",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	RoleValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
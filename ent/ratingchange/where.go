@@ -0,0 +1,507 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ratingchange
+
+import (
+	"app/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRole), v))
+	})
+}
+
+// Before applies equality check predicate on the "before" field. It's identical to BeforeEQ.
+func Before(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBefore), v))
+	})
+}
+
+// After applies equality check predicate on the "after" field. It's identical to AfterEQ.
+func After(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAfter), v))
+	})
+}
+
+// Won applies equality check predicate on the "won" field. It's identical to WonEQ.
+func Won(v bool) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWon), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldRole), v))
+	})
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldRole), v))
+	})
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...int) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldRole), v...))
+	})
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...int) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldRole), v...))
+	})
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldRole), v))
+	})
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldRole), v))
+	})
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldRole), v))
+	})
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldRole), v))
+	})
+}
+
+// BeforeEQ applies the EQ predicate on the "before" field.
+func BeforeEQ(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBefore), v))
+	})
+}
+
+// BeforeNEQ applies the NEQ predicate on the "before" field.
+func BeforeNEQ(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBefore), v))
+	})
+}
+
+// BeforeIn applies the In predicate on the "before" field.
+func BeforeIn(vs ...int) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBefore), v...))
+	})
+}
+
+// BeforeNotIn applies the NotIn predicate on the "before" field.
+func BeforeNotIn(vs ...int) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBefore), v...))
+	})
+}
+
+// BeforeGT applies the GT predicate on the "before" field.
+func BeforeGT(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBefore), v))
+	})
+}
+
+// BeforeGTE applies the GTE predicate on the "before" field.
+func BeforeGTE(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBefore), v))
+	})
+}
+
+// BeforeLT applies the LT predicate on the "before" field.
+func BeforeLT(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBefore), v))
+	})
+}
+
+// BeforeLTE applies the LTE predicate on the "before" field.
+func BeforeLTE(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBefore), v))
+	})
+}
+
+// AfterEQ applies the EQ predicate on the "after" field.
+func AfterEQ(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAfter), v))
+	})
+}
+
+// AfterNEQ applies the NEQ predicate on the "after" field.
+func AfterNEQ(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAfter), v))
+	})
+}
+
+// AfterIn applies the In predicate on the "after" field.
+func AfterIn(vs ...int) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAfter), v...))
+	})
+}
+
+// AfterNotIn applies the NotIn predicate on the "after" field.
+func AfterNotIn(vs ...int) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAfter), v...))
+	})
+}
+
+// AfterGT applies the GT predicate on the "after" field.
+func AfterGT(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAfter), v))
+	})
+}
+
+// AfterGTE applies the GTE predicate on the "after" field.
+func AfterGTE(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAfter), v))
+	})
+}
+
+// AfterLT applies the LT predicate on the "after" field.
+func AfterLT(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAfter), v))
+	})
+}
+
+// AfterLTE applies the LTE predicate on the "after" field.
+func AfterLTE(v int) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAfter), v))
+	})
+}
+
+// WonEQ applies the EQ predicate on the "won" field.
+func WonEQ(v bool) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWon), v))
+	})
+}
+
+// WonNEQ applies the NEQ predicate on the "won" field.
+func WonNEQ(v bool) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWon), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RatingChange {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.RatingChange(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasPlayer applies the HasEdge predicate on the "player" edge.
+func HasPlayer() predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PlayerTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPlayerWith applies the HasEdge predicate on the "player" edge with a given conditions (other predicates).
+func HasPlayerWith(preds ...predicate.Player) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(PlayerInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PlayerTable, PlayerColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RatingChange) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RatingChange) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RatingChange) predicate.RatingChange {
+	return predicate.RatingChange(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
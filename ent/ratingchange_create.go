@@ -0,0 +1,310 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/player"
+	"app/ent/ratingchange"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RatingChangeCreate is the builder for creating a RatingChange entity.
+type RatingChangeCreate struct {
+	config
+	mutation *RatingChangeMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (rcc *RatingChangeCreate) SetRole(i int) *RatingChangeCreate {
+	rcc.mutation.SetRole(i)
+	return rcc
+}
+
+// SetBefore sets the "before" field.
+func (rcc *RatingChangeCreate) SetBefore(i int) *RatingChangeCreate {
+	rcc.mutation.SetBefore(i)
+	return rcc
+}
+
+// SetAfter sets the "after" field.
+func (rcc *RatingChangeCreate) SetAfter(i int) *RatingChangeCreate {
+	rcc.mutation.SetAfter(i)
+	return rcc
+}
+
+// SetWon sets the "won" field.
+func (rcc *RatingChangeCreate) SetWon(b bool) *RatingChangeCreate {
+	rcc.mutation.SetWon(b)
+	return rcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *RatingChangeCreate) SetCreatedAt(t time.Time) *RatingChangeCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *RatingChangeCreate) SetNillableCreatedAt(t *time.Time) *RatingChangeCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rcc *RatingChangeCreate) SetPlayerID(id int) *RatingChangeCreate {
+	rcc.mutation.SetPlayerID(id)
+	return rcc
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rcc *RatingChangeCreate) SetPlayer(p *Player) *RatingChangeCreate {
+	return rcc.SetPlayerID(p.ID)
+}
+
+// Mutation returns the RatingChangeMutation object of the builder.
+func (rcc *RatingChangeCreate) Mutation() *RatingChangeMutation {
+	return rcc.mutation
+}
+
+// Save creates the RatingChange in the database.
+func (rcc *RatingChangeCreate) Save(ctx context.Context) (*RatingChange, error) {
+	var (
+		err  error
+		node *RatingChange
+	)
+	rcc.defaults()
+	if len(rcc.hooks) == 0 {
+		if err = rcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = rcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RatingChangeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rcc.check(); err != nil {
+				return nil, err
+			}
+			rcc.mutation = mutation
+			node, err = rcc.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rcc.hooks) - 1; i >= 0; i-- {
+			mut = rcc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rcc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *RatingChangeCreate) SaveX(ctx context.Context) *RatingChange {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *RatingChangeCreate) defaults() {
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		v := ratingchange.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *RatingChangeCreate) check() error {
+	if _, ok := rcc.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New("ent: missing required field \"role\"")}
+	}
+	if v, ok := rcc.mutation.Role(); ok {
+		if err := ratingchange.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := rcc.mutation.Before(); !ok {
+		return &ValidationError{Name: "before", err: errors.New("ent: missing required field \"before\"")}
+	}
+	if _, ok := rcc.mutation.After(); !ok {
+		return &ValidationError{Name: "after", err: errors.New("ent: missing required field \"after\"")}
+	}
+	if _, ok := rcc.mutation.Won(); !ok {
+		return &ValidationError{Name: "won", err: errors.New("ent: missing required field \"won\"")}
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New("ent: missing required field \"created_at\"")}
+	}
+	if _, ok := rcc.mutation.PlayerID(); !ok {
+		return &ValidationError{Name: "player", err: errors.New("ent: missing required edge \"player\"")}
+	}
+	return nil
+}
+
+func (rcc *RatingChangeCreate) sqlSave(ctx context.Context) (*RatingChange, error) {
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (rcc *RatingChangeCreate) createSpec() (*RatingChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RatingChange{config: rcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: ratingchange.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratingchange.FieldID,
+			},
+		}
+	)
+	if value, ok := rcc.mutation.Role(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldRole,
+		})
+		_node.Role = value
+	}
+	if value, ok := rcc.mutation.Before(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldBefore,
+		})
+		_node.Before = value
+	}
+	if value, ok := rcc.mutation.After(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldAfter,
+		})
+		_node.After = value
+	}
+	if value, ok := rcc.mutation.Won(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: ratingchange.FieldWon,
+		})
+		_node.Won = value
+	}
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratingchange.FieldCreatedAt,
+		})
+		_node.CreatedAt = value
+	}
+	if nodes := rcc.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratingchange.PlayerTable,
+			Columns: []string{ratingchange.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RatingChangeCreateBulk is the builder for creating many RatingChange entities in bulk.
+type RatingChangeCreateBulk struct {
+	config
+	builders []*RatingChangeCreate
+}
+
+// Save creates the RatingChange entities in the database.
+func (rccb *RatingChangeCreateBulk) Save(ctx context.Context) ([]*RatingChange, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*RatingChange, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RatingChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, &sqlgraph.BatchCreateSpec{Nodes: specs}); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				mutation.done = true
+				if err != nil {
+					return nil, err
+				}
+				id := specs[i].ID.Value.(int64)
+				nodes[i].ID = int(id)
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *RatingChangeCreateBulk) SaveX(ctx context.Context) []*RatingChange {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,108 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/predicate"
+	"app/ent/ratingchange"
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RatingChangeDelete is the builder for deleting a RatingChange entity.
+type RatingChangeDelete struct {
+	config
+	hooks    []Hook
+	mutation *RatingChangeMutation
+}
+
+// Where adds a new predicate to the RatingChangeDelete builder.
+func (rcd *RatingChangeDelete) Where(ps ...predicate.RatingChange) *RatingChangeDelete {
+	rcd.mutation.predicates = append(rcd.mutation.predicates, ps...)
+	return rcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rcd *RatingChangeDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rcd.hooks) == 0 {
+		affected, err = rcd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RatingChangeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			rcd.mutation = mutation
+			affected, err = rcd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rcd.hooks) - 1; i >= 0; i-- {
+			mut = rcd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rcd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcd *RatingChangeDelete) ExecX(ctx context.Context) int {
+	n, err := rcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rcd *RatingChangeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: ratingchange.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratingchange.FieldID,
+			},
+		},
+	}
+	if ps := rcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, rcd.driver, _spec)
+}
+
+// RatingChangeDeleteOne is the builder for deleting a single RatingChange entity.
+type RatingChangeDeleteOne struct {
+	rcd *RatingChangeDelete
+}
+
+// Exec executes the deletion query.
+func (rcdo *RatingChangeDeleteOne) Exec(ctx context.Context) error {
+	n, err := rcdo.rcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ratingchange.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcdo *RatingChangeDeleteOne) ExecX(ctx context.Context) {
+	rcdo.rcd.ExecX(ctx)
+}
@@ -0,0 +1,970 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/player"
+	"app/ent/predicate"
+	"app/ent/ratingchange"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RatingChangeQuery is the builder for querying RatingChange entities.
+type RatingChangeQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.RatingChange
+	// eager-loading edges.
+	withPlayer *PlayerQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RatingChangeQuery builder.
+func (rcq *RatingChangeQuery) Where(ps ...predicate.RatingChange) *RatingChangeQuery {
+	rcq.predicates = append(rcq.predicates, ps...)
+	return rcq
+}
+
+// Limit adds a limit step to the query.
+func (rcq *RatingChangeQuery) Limit(limit int) *RatingChangeQuery {
+	rcq.limit = &limit
+	return rcq
+}
+
+// Offset adds an offset step to the query.
+func (rcq *RatingChangeQuery) Offset(offset int) *RatingChangeQuery {
+	rcq.offset = &offset
+	return rcq
+}
+
+// Order adds an order step to the query.
+func (rcq *RatingChangeQuery) Order(o ...OrderFunc) *RatingChangeQuery {
+	rcq.order = append(rcq.order, o...)
+	return rcq
+}
+
+// QueryPlayer chains the current query on the "player" edge.
+func (rcq *RatingChangeQuery) QueryPlayer() *PlayerQuery {
+	query := &PlayerQuery{config: rcq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ratingchange.Table, ratingchange.FieldID, selector),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ratingchange.PlayerTable, ratingchange.PlayerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RatingChange entity from the query.
+// Returns a *NotFoundError when no RatingChange was found.
+func (rcq *RatingChangeQuery) First(ctx context.Context) (*RatingChange, error) {
+	nodes, err := rcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{ratingchange.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rcq *RatingChangeQuery) FirstX(ctx context.Context) *RatingChange {
+	node, err := rcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RatingChange ID from the query.
+// Returns a *NotFoundError when no RatingChange ID was found.
+func (rcq *RatingChangeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{ratingchange.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rcq *RatingChangeQuery) FirstIDX(ctx context.Context) int {
+	id, err := rcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RatingChange entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one RatingChange entity is not found.
+// Returns a *NotFoundError when no RatingChange entities are found.
+func (rcq *RatingChangeQuery) Only(ctx context.Context) (*RatingChange, error) {
+	nodes, err := rcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{ratingchange.Label}
+	default:
+		return nil, &NotSingularError{ratingchange.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rcq *RatingChangeQuery) OnlyX(ctx context.Context) *RatingChange {
+	node, err := rcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RatingChange ID in the query.
+// Returns a *NotSingularError when exactly one RatingChange ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (rcq *RatingChangeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = &NotSingularError{ratingchange.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rcq *RatingChangeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RatingChanges.
+func (rcq *RatingChangeQuery) All(ctx context.Context) ([]*RatingChange, error) {
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return rcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rcq *RatingChangeQuery) AllX(ctx context.Context) []*RatingChange {
+	nodes, err := rcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RatingChange IDs.
+func (rcq *RatingChangeQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := rcq.Select(ratingchange.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rcq *RatingChangeQuery) IDsX(ctx context.Context) []int {
+	ids, err := rcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rcq *RatingChangeQuery) Count(ctx context.Context) (int, error) {
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return rcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rcq *RatingChangeQuery) CountX(ctx context.Context) int {
+	count, err := rcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rcq *RatingChangeQuery) Exist(ctx context.Context) (bool, error) {
+	if err := rcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return rcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rcq *RatingChangeQuery) ExistX(ctx context.Context) bool {
+	exist, err := rcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RatingChangeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rcq *RatingChangeQuery) Clone() *RatingChangeQuery {
+	if rcq == nil {
+		return nil
+	}
+	return &RatingChangeQuery{
+		config:     rcq.config,
+		limit:      rcq.limit,
+		offset:     rcq.offset,
+		order:      append([]OrderFunc{}, rcq.order...),
+		predicates: append([]predicate.RatingChange{}, rcq.predicates...),
+		withPlayer: rcq.withPlayer.Clone(),
+		// clone intermediate query.
+		sql:  rcq.sql.Clone(),
+		path: rcq.path,
+	}
+}
+
+// WithPlayer tells the query-builder to eager-load the nodes that are connected to
+// the "player" edge. The optional arguments are used to configure the query builder of the edge.
+func (rcq *RatingChangeQuery) WithPlayer(opts ...func(*PlayerQuery)) *RatingChangeQuery {
+	query := &PlayerQuery{config: rcq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	rcq.withPlayer = query
+	return rcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Role int `json:"role,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RatingChange.Query().
+//		GroupBy(ratingchange.FieldRole).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (rcq *RatingChangeQuery) GroupBy(field string, fields ...string) *RatingChangeGroupBy {
+	group := &RatingChangeGroupBy{config: rcq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := rcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return rcq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Role int `json:"role,omitempty"`
+//	}
+//
+//	client.RatingChange.Query().
+//		Select(ratingchange.FieldRole).
+//		Scan(ctx, &v)
+//
+func (rcq *RatingChangeQuery) Select(field string, fields ...string) *RatingChangeSelect {
+	rcq.fields = append([]string{field}, fields...)
+	return &RatingChangeSelect{RatingChangeQuery: rcq}
+}
+
+func (rcq *RatingChangeQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range rcq.fields {
+		if !ratingchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rcq.path != nil {
+		prev, err := rcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rcq.sql = prev
+	}
+	return nil
+}
+
+func (rcq *RatingChangeQuery) sqlAll(ctx context.Context) ([]*RatingChange, error) {
+	var (
+		nodes       = []*RatingChange{}
+		withFKs     = rcq.withFKs
+		_spec       = rcq.querySpec()
+		loadedTypes = [1]bool{
+			rcq.withPlayer != nil,
+		}
+	)
+	if rcq.withPlayer != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, ratingchange.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &RatingChange{config: rcq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := rcq.withPlayer; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*RatingChange)
+		for i := range nodes {
+			if fk := nodes[i].player_ratings; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(player.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "player_ratings" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Player = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (rcq *RatingChangeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rcq.querySpec()
+	return sqlgraph.CountNodes(ctx, rcq.driver, _spec)
+}
+
+func (rcq *RatingChangeQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := rcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (rcq *RatingChangeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratingchange.Table,
+			Columns: ratingchange.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratingchange.FieldID,
+			},
+		},
+		From:   rcq.sql,
+		Unique: true,
+	}
+	if fields := rcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratingchange.FieldID)
+		for i := range fields {
+			if fields[i] != ratingchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector, ratingchange.ValidColumn)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rcq *RatingChangeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rcq.driver.Dialect())
+	t1 := builder.Table(ratingchange.Table)
+	selector := builder.Select(t1.Columns(ratingchange.Columns...)...).From(t1)
+	if rcq.sql != nil {
+		selector = rcq.sql
+		selector.Select(selector.Columns(ratingchange.Columns...)...)
+	}
+	for _, p := range rcq.predicates {
+		p(selector)
+	}
+	for _, p := range rcq.order {
+		p(selector, ratingchange.ValidColumn)
+	}
+	if offset := rcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RatingChangeGroupBy is the group-by builder for RatingChange entities.
+type RatingChangeGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rcgb *RatingChangeGroupBy) Aggregate(fns ...AggregateFunc) *RatingChangeGroupBy {
+	rcgb.fns = append(rcgb.fns, fns...)
+	return rcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (rcgb *RatingChangeGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := rcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	rcgb.sql = query
+	return rcgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := rcgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(rcgb.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := rcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) StringsX(ctx context.Context) []string {
+	v, err := rcgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rcgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) StringX(ctx context.Context) string {
+	v, err := rcgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(rcgb.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := rcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) IntsX(ctx context.Context) []int {
+	v, err := rcgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rcgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) IntX(ctx context.Context) int {
+	v, err := rcgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rcgb.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := rcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := rcgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rcgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := rcgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(rcgb.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := rcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := rcgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (rcgb *RatingChangeGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rcgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rcgb *RatingChangeGroupBy) BoolX(ctx context.Context) bool {
+	v, err := rcgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rcgb *RatingChangeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range rcgb.fields {
+		if !ratingchange.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := rcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rcgb *RatingChangeGroupBy) sqlQuery() *sql.Selector {
+	selector := rcgb.sql
+	columns := make([]string, 0, len(rcgb.fields)+len(rcgb.fns))
+	columns = append(columns, rcgb.fields...)
+	for _, fn := range rcgb.fns {
+		columns = append(columns, fn(selector, ratingchange.ValidColumn))
+	}
+	return selector.Select(columns...).GroupBy(rcgb.fields...)
+}
+
+// RatingChangeSelect is the builder for selecting fields of RatingChange entities.
+type RatingChangeSelect struct {
+	*RatingChangeQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rcs *RatingChangeSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := rcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	rcs.sql = rcs.RatingChangeQuery.sqlQuery(ctx)
+	return rcs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (rcs *RatingChangeSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := rcs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(rcs.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := rcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (rcs *RatingChangeSelect) StringsX(ctx context.Context) []string {
+	v, err := rcs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = rcs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (rcs *RatingChangeSelect) StringX(ctx context.Context) string {
+	v, err := rcs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(rcs.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := rcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (rcs *RatingChangeSelect) IntsX(ctx context.Context) []int {
+	v, err := rcs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = rcs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (rcs *RatingChangeSelect) IntX(ctx context.Context) int {
+	v, err := rcs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(rcs.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := rcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (rcs *RatingChangeSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := rcs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = rcs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (rcs *RatingChangeSelect) Float64X(ctx context.Context) float64 {
+	v, err := rcs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(rcs.fields) > 1 {
+		return nil, errors.New("ent: RatingChangeSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := rcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (rcs *RatingChangeSelect) BoolsX(ctx context.Context) []bool {
+	v, err := rcs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (rcs *RatingChangeSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = rcs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{ratingchange.Label}
+	default:
+		err = fmt.Errorf("ent: RatingChangeSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (rcs *RatingChangeSelect) BoolX(ctx context.Context) bool {
+	v, err := rcs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (rcs *RatingChangeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := rcs.sqlQuery().Query()
+	if err := rcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (rcs *RatingChangeSelect) sqlQuery() sql.Querier {
+	selector := rcs.sql
+	selector.Select(selector.Columns(rcs.fields...)...)
+	return selector
+}
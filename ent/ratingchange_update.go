@@ -0,0 +1,587 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"app/ent/player"
+	"app/ent/predicate"
+	"app/ent/ratingchange"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RatingChangeUpdate is the builder for updating RatingChange entities.
+type RatingChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RatingChangeMutation
+}
+
+// Where adds a new predicate for the RatingChangeUpdate builder.
+func (rcu *RatingChangeUpdate) Where(ps ...predicate.RatingChange) *RatingChangeUpdate {
+	rcu.mutation.predicates = append(rcu.mutation.predicates, ps...)
+	return rcu
+}
+
+// SetRole sets the "role" field.
+func (rcu *RatingChangeUpdate) SetRole(i int) *RatingChangeUpdate {
+	rcu.mutation.ResetRole()
+	rcu.mutation.SetRole(i)
+	return rcu
+}
+
+// AddRole adds i to the "role" field.
+func (rcu *RatingChangeUpdate) AddRole(i int) *RatingChangeUpdate {
+	rcu.mutation.AddRole(i)
+	return rcu
+}
+
+// SetBefore sets the "before" field.
+func (rcu *RatingChangeUpdate) SetBefore(i int) *RatingChangeUpdate {
+	rcu.mutation.ResetBefore()
+	rcu.mutation.SetBefore(i)
+	return rcu
+}
+
+// AddBefore adds i to the "before" field.
+func (rcu *RatingChangeUpdate) AddBefore(i int) *RatingChangeUpdate {
+	rcu.mutation.AddBefore(i)
+	return rcu
+}
+
+// SetAfter sets the "after" field.
+func (rcu *RatingChangeUpdate) SetAfter(i int) *RatingChangeUpdate {
+	rcu.mutation.ResetAfter()
+	rcu.mutation.SetAfter(i)
+	return rcu
+}
+
+// AddAfter adds i to the "after" field.
+func (rcu *RatingChangeUpdate) AddAfter(i int) *RatingChangeUpdate {
+	rcu.mutation.AddAfter(i)
+	return rcu
+}
+
+// SetWon sets the "won" field.
+func (rcu *RatingChangeUpdate) SetWon(b bool) *RatingChangeUpdate {
+	rcu.mutation.SetWon(b)
+	return rcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcu *RatingChangeUpdate) SetCreatedAt(t time.Time) *RatingChangeUpdate {
+	rcu.mutation.SetCreatedAt(t)
+	return rcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcu *RatingChangeUpdate) SetNillableCreatedAt(t *time.Time) *RatingChangeUpdate {
+	if t != nil {
+		rcu.SetCreatedAt(*t)
+	}
+	return rcu
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rcu *RatingChangeUpdate) SetPlayerID(id int) *RatingChangeUpdate {
+	rcu.mutation.SetPlayerID(id)
+	return rcu
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rcu *RatingChangeUpdate) SetPlayer(p *Player) *RatingChangeUpdate {
+	return rcu.SetPlayerID(p.ID)
+}
+
+// Mutation returns the RatingChangeMutation object of the builder.
+func (rcu *RatingChangeUpdate) Mutation() *RatingChangeMutation {
+	return rcu.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (rcu *RatingChangeUpdate) ClearPlayer() *RatingChangeUpdate {
+	rcu.mutation.ClearPlayer()
+	return rcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *RatingChangeUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(rcu.hooks) == 0 {
+		if err = rcu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = rcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RatingChangeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rcu.check(); err != nil {
+				return 0, err
+			}
+			rcu.mutation = mutation
+			affected, err = rcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(rcu.hooks) - 1; i >= 0; i-- {
+			mut = rcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *RatingChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *RatingChangeUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *RatingChangeUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcu *RatingChangeUpdate) check() error {
+	if v, ok := rcu.mutation.Role(); ok {
+		if err := ratingchange.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := rcu.mutation.PlayerID(); rcu.mutation.PlayerCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"player\"")
+	}
+	return nil
+}
+
+func (rcu *RatingChangeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratingchange.Table,
+			Columns: ratingchange.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratingchange.FieldID,
+			},
+		},
+	}
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldRole,
+		})
+	}
+	if value, ok := rcu.mutation.AddedRole(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldRole,
+		})
+	}
+	if value, ok := rcu.mutation.Before(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldBefore,
+		})
+	}
+	if value, ok := rcu.mutation.AddedBefore(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldBefore,
+		})
+	}
+	if value, ok := rcu.mutation.After(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldAfter,
+		})
+	}
+	if value, ok := rcu.mutation.AddedAfter(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldAfter,
+		})
+	}
+	if value, ok := rcu.mutation.Won(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: ratingchange.FieldWon,
+		})
+	}
+	if value, ok := rcu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratingchange.FieldCreatedAt,
+		})
+	}
+	if rcu.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratingchange.PlayerTable,
+			Columns: []string{ratingchange.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcu.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratingchange.PlayerTable,
+			Columns: []string{ratingchange.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratingchange.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// RatingChangeUpdateOne is the builder for updating a single RatingChange entity.
+type RatingChangeUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *RatingChangeMutation
+}
+
+// SetRole sets the "role" field.
+func (rcuo *RatingChangeUpdateOne) SetRole(i int) *RatingChangeUpdateOne {
+	rcuo.mutation.ResetRole()
+	rcuo.mutation.SetRole(i)
+	return rcuo
+}
+
+// AddRole adds i to the "role" field.
+func (rcuo *RatingChangeUpdateOne) AddRole(i int) *RatingChangeUpdateOne {
+	rcuo.mutation.AddRole(i)
+	return rcuo
+}
+
+// SetBefore sets the "before" field.
+func (rcuo *RatingChangeUpdateOne) SetBefore(i int) *RatingChangeUpdateOne {
+	rcuo.mutation.ResetBefore()
+	rcuo.mutation.SetBefore(i)
+	return rcuo
+}
+
+// AddBefore adds i to the "before" field.
+func (rcuo *RatingChangeUpdateOne) AddBefore(i int) *RatingChangeUpdateOne {
+	rcuo.mutation.AddBefore(i)
+	return rcuo
+}
+
+// SetAfter sets the "after" field.
+func (rcuo *RatingChangeUpdateOne) SetAfter(i int) *RatingChangeUpdateOne {
+	rcuo.mutation.ResetAfter()
+	rcuo.mutation.SetAfter(i)
+	return rcuo
+}
+
+// AddAfter adds i to the "after" field.
+func (rcuo *RatingChangeUpdateOne) AddAfter(i int) *RatingChangeUpdateOne {
+	rcuo.mutation.AddAfter(i)
+	return rcuo
+}
+
+// SetWon sets the "won" field.
+func (rcuo *RatingChangeUpdateOne) SetWon(b bool) *RatingChangeUpdateOne {
+	rcuo.mutation.SetWon(b)
+	return rcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcuo *RatingChangeUpdateOne) SetCreatedAt(t time.Time) *RatingChangeUpdateOne {
+	rcuo.mutation.SetCreatedAt(t)
+	return rcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcuo *RatingChangeUpdateOne) SetNillableCreatedAt(t *time.Time) *RatingChangeUpdateOne {
+	if t != nil {
+		rcuo.SetCreatedAt(*t)
+	}
+	return rcuo
+}
+
+// SetPlayerID sets the "player" edge to the Player entity by ID.
+func (rcuo *RatingChangeUpdateOne) SetPlayerID(id int) *RatingChangeUpdateOne {
+	rcuo.mutation.SetPlayerID(id)
+	return rcuo
+}
+
+// SetPlayer sets the "player" edge to the Player entity.
+func (rcuo *RatingChangeUpdateOne) SetPlayer(p *Player) *RatingChangeUpdateOne {
+	return rcuo.SetPlayerID(p.ID)
+}
+
+// Mutation returns the RatingChangeMutation object of the builder.
+func (rcuo *RatingChangeUpdateOne) Mutation() *RatingChangeMutation {
+	return rcuo.mutation
+}
+
+// ClearPlayer clears the "player" edge to the Player entity.
+func (rcuo *RatingChangeUpdateOne) ClearPlayer() *RatingChangeUpdateOne {
+	rcuo.mutation.ClearPlayer()
+	return rcuo
+}
+
+// Save executes the query and returns the updated RatingChange entity.
+func (rcuo *RatingChangeUpdateOne) Save(ctx context.Context) (*RatingChange, error) {
+	var (
+		err  error
+		node *RatingChange
+	)
+	if len(rcuo.hooks) == 0 {
+		if err = rcuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = rcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*RatingChangeMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = rcuo.check(); err != nil {
+				return nil, err
+			}
+			rcuo.mutation = mutation
+			node, err = rcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(rcuo.hooks) - 1; i >= 0; i-- {
+			mut = rcuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, rcuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *RatingChangeUpdateOne) SaveX(ctx context.Context) *RatingChange {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *RatingChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *RatingChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcuo *RatingChangeUpdateOne) check() error {
+	if v, ok := rcuo.mutation.Role(); ok {
+		if err := ratingchange.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %w", err)}
+		}
+	}
+	if _, ok := rcuo.mutation.PlayerID(); rcuo.mutation.PlayerCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"player\"")
+	}
+	return nil
+}
+
+func (rcuo *RatingChangeUpdateOne) sqlSave(ctx context.Context) (_node *RatingChange, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   ratingchange.Table,
+			Columns: ratingchange.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: ratingchange.FieldID,
+			},
+		},
+	}
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing RatingChange.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.Role(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldRole,
+		})
+	}
+	if value, ok := rcuo.mutation.AddedRole(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldRole,
+		})
+	}
+	if value, ok := rcuo.mutation.Before(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldBefore,
+		})
+	}
+	if value, ok := rcuo.mutation.AddedBefore(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldBefore,
+		})
+	}
+	if value, ok := rcuo.mutation.After(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldAfter,
+		})
+	}
+	if value, ok := rcuo.mutation.AddedAfter(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: ratingchange.FieldAfter,
+		})
+	}
+	if value, ok := rcuo.mutation.Won(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: ratingchange.FieldWon,
+		})
+	}
+	if value, ok := rcuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: ratingchange.FieldCreatedAt,
+		})
+	}
+	if rcuo.mutation.PlayerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratingchange.PlayerTable,
+			Columns: []string{ratingchange.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rcuo.mutation.PlayerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ratingchange.PlayerTable,
+			Columns: []string{ratingchange.PlayerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: player.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RatingChange{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratingchange.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return _node, nil
+}
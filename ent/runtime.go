@@ -3,8 +3,12 @@
 package ent
 
 import (
+	"app/ent/deck"
 	"app/ent/game"
+	"app/ent/ownedcard"
+	"app/ent/participant"
 	"app/ent/player"
+	"app/ent/ratingchange"
 	"app/ent/schema"
 	"time"
 )
@@ -13,16 +17,106 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	deckFields := schema.Deck{}.Fields()
+	_ = deckFields
+	// deckDescName is the schema descriptor for name field.
+	deckDescName := deckFields[0].Descriptor()
+	// deck.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deck.NameValidator = func() func(string) error {
+		validators := deckDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deckDescCreatedAt is the schema descriptor for created_at field.
+	deckDescCreatedAt := deckFields[2].Descriptor()
+	// deck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deck.DefaultCreatedAt = deckDescCreatedAt.Default.(func() time.Time)
+	// deckDescUpdatedAt is the schema descriptor for updated_at field.
+	deckDescUpdatedAt := deckFields[3].Descriptor()
+	// deck.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deck.DefaultUpdatedAt = deckDescUpdatedAt.Default.(func() time.Time)
+	// deck.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deck.UpdateDefaultUpdatedAt = deckDescUpdatedAt.UpdateDefault.(func() time.Time)
 	gameFields := schema.Game{}.Fields()
 	_ = gameFields
+	// gameDescWinner is the schema descriptor for winner field.
+	gameDescWinner := gameFields[2].Descriptor()
+	// game.WinnerValidator is a validator for the "winner" field. It is called by the builders before save.
+	game.WinnerValidator = func() func(int) error {
+		validators := gameDescWinner.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(winner int) error {
+			for _, fn := range fns {
+				if err := fn(winner); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// gameDescDuration is the schema descriptor for duration field.
+	gameDescDuration := gameFields[3].Descriptor()
+	// game.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	game.DurationValidator = gameDescDuration.Validators[0].(func(int) error)
 	// gameDescCreatedAt is the schema descriptor for created_at field.
-	gameDescCreatedAt := gameFields[2].Descriptor()
+	gameDescCreatedAt := gameFields[5].Descriptor()
 	// game.DefaultCreatedAt holds the default value on creation for the created_at field.
 	game.DefaultCreatedAt = gameDescCreatedAt.Default.(func() time.Time)
 	// gameDescID is the schema descriptor for id field.
 	gameDescID := gameFields[0].Descriptor()
 	// game.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	game.IDValidator = gameDescID.Validators[0].(func(int) error)
+	ownedcardFields := schema.OwnedCard{}.Fields()
+	_ = ownedcardFields
+	// ownedcardDescCard is the schema descriptor for card field.
+	ownedcardDescCard := ownedcardFields[0].Descriptor()
+	// ownedcard.CardValidator is a validator for the "card" field. It is called by the builders before save.
+	ownedcard.CardValidator = ownedcardDescCard.Validators[0].(func(int) error)
+	// ownedcardDescCreatedAt is the schema descriptor for created_at field.
+	ownedcardDescCreatedAt := ownedcardFields[2].Descriptor()
+	// ownedcard.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ownedcard.DefaultCreatedAt = ownedcardDescCreatedAt.Default.(func() time.Time)
+	participantFields := schema.Participant{}.Fields()
+	_ = participantFields
+	// participantDescName is the schema descriptor for name field.
+	participantDescName := participantFields[0].Descriptor()
+	// participant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	participant.NameValidator = participantDescName.Validators[0].(func(string) error)
+	// participantDescTeam is the schema descriptor for team field.
+	participantDescTeam := participantFields[1].Descriptor()
+	// participant.TeamValidator is a validator for the "team" field. It is called by the builders before save.
+	participant.TeamValidator = func() func(int) error {
+		validators := participantDescTeam.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(team int) error {
+			for _, fn := range fns {
+				if err := fn(team); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// participantDescLeft is the schema descriptor for left field.
+	participantDescLeft := participantFields[3].Descriptor()
+	// participant.DefaultLeft holds the default value on creation for the left field.
+	participant.DefaultLeft = participantDescLeft.Default.(bool)
 	playerFields := schema.Player{}.Fields()
 	_ = playerFields
 	// playerDescType is the schema descriptor for type field.
@@ -33,4 +127,54 @@ func init() {
 	playerDescName := playerFields[1].Descriptor()
 	// player.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	player.NameValidator = playerDescName.Validators[0].(func(string) error)
+	// playerDescRating is the schema descriptor for rating field.
+	playerDescRating := playerFields[2].Descriptor()
+	// player.DefaultRating holds the default value on creation for the rating field.
+	player.DefaultRating = playerDescRating.Default.(int)
+	// playerDescDefenderRating is the schema descriptor for defender_rating field.
+	playerDescDefenderRating := playerFields[3].Descriptor()
+	// player.DefaultDefenderRating holds the default value on creation for the defender_rating field.
+	player.DefaultDefenderRating = playerDescDefenderRating.Default.(int)
+	// playerDescAttackerRating is the schema descriptor for attacker_rating field.
+	playerDescAttackerRating := playerFields[4].Descriptor()
+	// player.DefaultAttackerRating holds the default value on creation for the attacker_rating field.
+	player.DefaultAttackerRating = playerDescAttackerRating.Default.(int)
+	// playerDescSemoRating is the schema descriptor for semo_rating field.
+	playerDescSemoRating := playerFields[5].Descriptor()
+	// player.DefaultSemoRating holds the default value on creation for the semo_rating field.
+	player.DefaultSemoRating = playerDescSemoRating.Default.(int)
+	// playerDescExperience is the schema descriptor for experience field.
+	playerDescExperience := playerFields[6].Descriptor()
+	// player.DefaultExperience holds the default value on creation for the experience field.
+	player.DefaultExperience = playerDescExperience.Default.(int)
+	// player.ExperienceValidator is a validator for the "experience" field. It is called by the builders before save.
+	player.ExperienceValidator = playerDescExperience.Validators[0].(func(int) error)
+	// playerDescStarterGranted is the schema descriptor for starter_granted field.
+	playerDescStarterGranted := playerFields[7].Descriptor()
+	// player.DefaultStarterGranted holds the default value on creation for the starter_granted field.
+	player.DefaultStarterGranted = playerDescStarterGranted.Default.(bool)
+	ratingchangeFields := schema.RatingChange{}.Fields()
+	_ = ratingchangeFields
+	// ratingchangeDescRole is the schema descriptor for role field.
+	ratingchangeDescRole := ratingchangeFields[0].Descriptor()
+	// ratingchange.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	ratingchange.RoleValidator = func() func(int) error {
+		validators := ratingchangeDescRole.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(role int) error {
+			for _, fn := range fns {
+				if err := fn(role); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ratingchangeDescCreatedAt is the schema descriptor for created_at field.
+	ratingchangeDescCreatedAt := ratingchangeFields[4].Descriptor()
+	// ratingchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ratingchange.DefaultCreatedAt = ratingchangeDescCreatedAt.Default.(func() time.Time)
 }
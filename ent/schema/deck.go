@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Deck holds a named deck a player saved to pick before a match.
+type Deck struct {
+	ent.Schema
+}
+
+// Fields of the Deck.
+func (Deck) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(20).
+			NotEmpty(),
+		field.JSON("cards", []uint32{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Deck.
+func (Deck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Player.Type).
+			Ref("decks").
+			Unique().
+			Required(),
+	}
+}
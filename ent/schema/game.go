@@ -24,6 +24,15 @@ func (Game) Fields() []ent.Field {
 			Positive(),
 		field.JSON("events", []string{}).
 			Optional(),
+		field.Int("winner").
+			Min(0).
+			Max(2),
+		// frame 단위
+		field.Int("duration").
+			NonNegative(),
+		// 이 판에 쓴 카드/유닛 표
+		field.String("catalog_version").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now),
 	}
@@ -33,6 +42,7 @@ func (Game) Fields() []ent.Field {
 func (Game) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("players", Player.Type),
+		edge.To("participants", Participant.Type),
 	}
 }
 
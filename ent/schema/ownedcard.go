@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OwnedCard is one card in a player's collection.
+type OwnedCard struct {
+	ent.Schema
+}
+
+// Fields of the OwnedCard.
+func (OwnedCard) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("card").
+			Positive(),
+		// 어떻게 얻었는지
+		field.Enum("source").
+			Values("starter", "unlock", "admin"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OwnedCard.
+func (OwnedCard) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", Player.Type).
+			Ref("cards").
+			Unique().
+			Required(),
+	}
+}
+
+func (OwnedCard) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("card").
+			Edges("owner").
+			Unique(),
+	}
+}
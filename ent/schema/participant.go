@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Participant holds one player's seat in a recorded game.
+type Participant struct {
+	ent.Schema
+}
+
+// Fields of the Participant.
+func (Participant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			MaxLen(20).
+			Optional(),
+		field.Int("team").
+			Min(0).
+			Max(2),
+		field.JSON("deck", []uint32{}).
+			Optional(),
+		// 끝나기 전에 나갔다
+		field.Bool("left").
+			Default(false),
+	}
+}
+
+// Edges of the Participant.
+func (Participant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("game", Game.Type).
+			Ref("participants").
+			Unique().
+			Required(),
+		// 이름 없이 들어온 플레이어는 기록만 남긴다
+		edge.From("player", Player.Type).
+			Ref("participations").
+			Unique(),
+	}
+}
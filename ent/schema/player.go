@@ -20,6 +20,25 @@ func (Player) Fields() []ent.Field {
 			MaxLen(20).
 			Unique().
 			Immutable(),
+		field.Int("rating").
+			Default(1000),
+		// 역할별 레이팅
+		field.Int("defender_rating").
+			Default(1000),
+		field.Int("attacker_rating").
+			Default(1000),
+		field.Int("semo_rating").
+			Default(1000),
+		field.Int("experience").
+			Default(0).
+			NonNegative(),
+		// 시작 카드를 이미 받았는지, 회수한 카드가 다시 생기지 않게
+		field.Bool("starter_granted").
+			Default(false),
+		// hex(salt 16 byte + sha256(salt + secret)), 처음 로그인할 때 정해진다
+		field.String("secret").
+			Optional().
+			Sensitive(),
 	}
 }
 
@@ -29,5 +48,9 @@ func (Player) Edges() []ent.Edge {
 		edge.From("game", Game.Type).
 			Ref("players").
 			Unique(),
+		edge.To("ratings", RatingChange.Type),
+		edge.To("participations", Participant.Type),
+		edge.To("decks", Deck.Type),
+		edge.To("cards", OwnedCard.Type),
 	}
 }
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RatingChange holds one rating update of a player after a match.
+type RatingChange struct {
+	ent.Schema
+}
+
+// Fields of the RatingChange.
+func (RatingChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("role").
+			Min(0).
+			Max(2),
+		field.Int("before"),
+		field.Int("after"),
+		field.Bool("won"),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the RatingChange.
+func (RatingChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("player", Player.Type).
+			Ref("ratings").
+			Unique().
+			Required(),
+	}
+}
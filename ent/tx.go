@@ -12,10 +12,18 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Deck is the client for interacting with the Deck builders.
+	Deck *DeckClient
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
+	// OwnedCard is the client for interacting with the OwnedCard builders.
+	OwnedCard *OwnedCardClient
+	// Participant is the client for interacting with the Participant builders.
+	Participant *ParticipantClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
+	// RatingChange is the client for interacting with the RatingChange builders.
+	RatingChange *RatingChangeClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,8 +159,12 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Deck = NewDeckClient(tx.config)
 	tx.Game = NewGameClient(tx.config)
+	tx.OwnedCard = NewOwnedCardClient(tx.config)
+	tx.Participant = NewParticipantClient(tx.config)
 	tx.Player = NewPlayerClient(tx.config)
+	tx.RatingChange = NewRatingChangeClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -162,7 +174,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Game.QueryXXX(), the query will be executed
+// applies a query, for example: Deck.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,81 @@
+package gateway
+
+import (
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"app/protocol"
+
+	"github.com/gorilla/websocket"
+)
+
+var ErrNotBinary = errors.New("websocket message is not binary")
+
+// WebSocketConn carries one game frame per binary websocket message.
+type WebSocketConn struct {
+	ws    *websocket.Conn
+	mutex sync.Mutex
+}
+
+func NewWebSocketConn(ws *websocket.Conn) *WebSocketConn {
+	ws.SetReadLimit(protocol.MaxFrameSize)
+	return &WebSocketConn{ws: ws}
+}
+
+func (c *WebSocketConn) ReadFrame() ([]byte, error) {
+	t, data, err := c.ws.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	if t != websocket.BinaryMessage {
+		return nil, ErrNotBinary
+	}
+	return data, nil
+}
+
+func (c *WebSocketConn) WriteFrame(data []byte) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.ws.WriteMessage(websocket.BinaryMessage, data)
+}
+
+func (c *WebSocketConn) Close() error {
+	return c.ws.Close()
+}
+
+func (c *WebSocketConn) RemoteAddr() net.Addr {
+	return c.ws.RemoteAddr()
+}
+
+func (c *WebSocketConn) SetReadDeadline(t time.Time) error {
+	return c.ws.SetReadDeadline(t)
+}
+
+func (c *WebSocketConn) SetWriteDeadline(t time.Time) error {
+	return c.ws.SetWriteDeadline(t)
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  protocol.MaxFrameSize,
+	WriteBufferSize: protocol.MaxFrameSize,
+	// 웹 클라이언트는 다른 origin 에서 접속한다
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// ListenWebSocket serves websocket upgrades on addr and hands every connection to accept.
+func ListenWebSocket(addr string, accept func(protocol.Conn)) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		accept(NewWebSocketConn(ws))
+	})
+	return http.ListenAndServe(addr, mux)
+}
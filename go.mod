@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	entgo.io/ent v0.6.0
+	github.com/gorilla/websocket v1.4.2
 	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/ybs1164/quadtree-go v0.0.0-20210223090845-0591ab13a6fa
 )
@@ -0,0 +1,392 @@
+package lobby
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"app/object/games"
+	"app/protocol"
+)
+
+var (
+	ErrRoomNotFound  = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "room not found"}
+	ErrWrongPassword = protocol.Error{Code: protocol.ErrCodeWrongPassword, Message: "wrong password"}
+	ErrNoRoom        = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "no room to spectate"}
+)
+
+// 헷갈리는 글자(0, O, 1, I)는 뺀다
+const codeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+type Room struct {
+	ID       uint64
+	Name     string
+	Code     string // 비공개 방 입장 코드
+	Private  bool
+	Playback bool // 리플레이 재생 방, 비어도 닫지 않는다
+	Game     *games.Game
+	password string
+
+	seats      map[string]byte // 매칭된 이름 -> 팀, 들어오면 지운다
+	seatsUntil time.Time
+}
+
+func (room *Room) Locked() bool {
+	return room.password != ""
+}
+
+func (room *Room) checkPassword(password string) bool {
+	return subtle.ConstantTimeCompare([]byte(room.password), []byte(password)) == 1
+}
+
+type MatchStore interface {
+	SaveMatch(ctx context.Context, res games.Result) (int, error)
+}
+
+// AccountStore proves who a connection is. Ratings, saved decks and
+// collections are only kept for names that logged in.
+type AccountStore interface {
+	Login(ctx context.Context, name, secret string) error
+}
+
+// ProgressStore hands out experience, and the cards it unlocks, for a finished match.
+type ProgressStore interface {
+	AwardExperience(ctx context.Context, res games.Result) error
+}
+
+type RoomInfo struct {
+	ID      uint64
+	Name    string
+	Locked  bool
+	Private bool
+	games.Info
+}
+
+// Registry owns every room on the server. All methods are safe for concurrent use.
+type Registry struct {
+	Matcher *Matcher        // nil 이면 매칭 없음
+	Ratings RatingStore     // nil 이면 레이팅 없음
+	Matches MatchStore      // nil 이면 기록 안 함
+	Decks   games.DeckStore // nil 이면 덱 저장 안 함
+	Replays string          // 리플레이 파일을 남길 폴더, 비어 있으면 안 남긴다
+
+	Collection games.CollectionStore // nil 이면 모든 카드를 쓸 수 있다
+	Progress   ProgressStore         // nil 이면 경험치를 주지 않는다
+	Accounts   AccountStore          // nil 이면 이름을 확인하지 않는다
+
+	mutex  sync.Mutex
+	rooms  map[uint64]*Room
+	codes  map[string]uint64
+	nextID uint64
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		rooms: map[uint64]*Room{},
+		codes: map[string]uint64{},
+	}
+}
+
+func (r *Registry) Create(name, password string, private bool) *Room {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.create(name, password, private)
+}
+
+func (r *Registry) create(name, password string, private bool) *Room {
+	r.nextID++
+	room := &Room{
+		ID:       r.nextID,
+		Name:     name,
+		Private:  private,
+		Game:     games.NewGame(r.nextID),
+		password: password,
+	}
+	if private {
+		room.Code = r.newCode()
+		r.codes[room.Code] = room.ID
+	}
+	room.Game.OnEmpty = func(*games.Game) {
+		r.Remove(room.ID)
+	}
+	room.Game.OnEnd = func(g *games.Game, res games.Result) {
+		go r.record(room, res)
+	}
+	room.Game.Decks = r.Decks
+	room.Game.Collection = r.Collection
+	r.rooms[room.ID] = room
+	log.Println("room created", room.ID, room.Name)
+	return room
+}
+
+// Playback opens a watch-only room that plays rep back to its spectators.
+func (r *Registry) Playback(name string, rep *games.Replay) (*Room, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	g, err := games.NewPlayback(r.nextID+1, rep)
+	if err != nil {
+		return nil, err
+	}
+	r.nextID++
+	room := &Room{
+		ID:       r.nextID,
+		Name:     name,
+		Playback: true,
+		Game:     g,
+	}
+	r.rooms[room.ID] = room
+	log.Println("replay room created", room.ID, room.Name)
+	return room, nil
+}
+
+// newCode expects r.mutex to be held.
+func (r *Registry) newCode() string {
+	b := make([]byte, protocol.RoomCodeLength)
+	for {
+		if _, err := rand.Read(b); err != nil {
+			log.Println(err)
+		}
+		for i := range b {
+			b[i] = codeAlphabet[int(b[i])%len(codeAlphabet)]
+		}
+		if _, ok := r.codes[string(b)]; !ok {
+			return string(b)
+		}
+	}
+}
+
+func (r *Registry) Get(id uint64) (*Room, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	room, ok := r.rooms[id]
+	return room, ok
+}
+
+// List returns every room, private ones included, ordered by ID.
+func (r *Registry) List() []RoomInfo {
+	r.mutex.Lock()
+	rooms := r.sorted()
+	r.mutex.Unlock()
+
+	list := make([]RoomInfo, 0, len(rooms))
+	for _, room := range rooms {
+		list = append(list, RoomInfo{
+			ID:      room.ID,
+			Name:    room.Name,
+			Locked:  room.Locked(),
+			Private: room.Private,
+			Info:    room.Game.Info(),
+		})
+	}
+	return list
+}
+
+// Browse is the room list shown to clients; private rooms are left out.
+func (r *Registry) Browse() protocol.RoomList {
+	var l protocol.RoomList
+	for _, info := range r.List() {
+		if info.Private {
+			continue
+		}
+		e := protocol.RoomEntry{
+			ID:         info.ID,
+			Name:       info.Name,
+			Players:    byte(info.Players),
+			Spectators: byte(info.Spectators),
+			Status:     info.Status,
+			Locked:     info.Locked,
+		}
+		for team, on := range info.Teams {
+			if on {
+				e.Teams |= 1 << team
+			}
+		}
+		l.Rooms = append(l.Rooms, e)
+	}
+	return l
+}
+
+// enter expects r.mutex to be held.
+// account is the name c logged in as, "" for guests.
+func (r *Registry) enter(room *Room, c protocol.Conn, reply protocol.HelloReply, role byte, account string) error {
+	joined := protocol.RoomJoined{ID: room.ID, Code: room.Code}.Encode()
+	if role == protocol.RoleSpectator {
+		return room.Game.Spectate(c, reply, joined)
+	}
+	return room.Game.Join(c, reply, account, joined)
+}
+
+// CreateAndJoin creates a room and puts c in it before anyone else can see it.
+func (r *Registry) CreateAndJoin(m protocol.CreateRoom, c protocol.Conn, reply protocol.HelloReply, role byte, account string) (*Room, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	room := r.create(m.Name, m.Password, m.Private)
+	return room, r.enter(room, c, reply, role, account)
+}
+
+func (r *Registry) Join(id uint64, password string, c protocol.Conn, reply protocol.HelloReply, role byte, account string) (*Room, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	room, ok := r.rooms[id]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+	if room.Locked() && !room.checkPassword(password) {
+		return nil, ErrWrongPassword
+	}
+	return room, r.enter(room, c, reply, role, account)
+}
+
+// JoinCode joins a private room by its code. The code stands in for the password.
+func (r *Registry) JoinCode(code string, c protocol.Conn, reply protocol.HelloReply, role byte, account string) (*Room, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	id, ok := r.codes[code]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+	return r.rooms[id], r.enter(r.rooms[id], c, reply, role, account)
+}
+
+// QuickJoin puts c into the first open waiting room with a free slot, creating one if none has.
+func (r *Registry) QuickJoin(c protocol.Conn, reply protocol.HelloReply, account string) (*Room, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, room := range r.sorted() {
+		if room.Private || room.Locked() {
+			continue
+		}
+		info := room.Game.Info()
+		if info.Status != games.StatusReady || info.Players >= 3 {
+			continue
+		}
+		if err := r.enter(room, c, reply, protocol.RolePlayer, account); err == nil {
+			return room, nil
+		}
+	}
+	room := r.create("", "", false)
+	return room, r.enter(room, c, reply, protocol.RolePlayer, account)
+}
+
+// Spectate attaches c to the first open room that has players in it.
+func (r *Registry) Spectate(c protocol.Conn, reply protocol.HelloReply) (*Room, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var err error = ErrNoRoom
+	for _, room := range r.sorted() {
+		if room.Private || room.Locked() || room.Game.Info().Players == 0 {
+			continue
+		}
+		if err = r.enter(room, c, reply, protocol.RoleSpectator, ""); err == nil {
+			return room, nil
+		}
+	}
+	return nil, err
+}
+
+// Revoke tells every room that account no longer owns card id.
+func (r *Registry) Revoke(account string, id uint32) {
+	r.mutex.Lock()
+	rooms := r.sorted()
+	r.mutex.Unlock()
+
+	for _, room := range rooms {
+		room.Game.Revoke(account, id)
+	}
+}
+
+func (r *Registry) Leave(id uint64, p *games.Player) error {
+	room, ok := r.Get(id)
+	if !ok {
+		return ErrRoomNotFound
+	}
+	room.Game.Left(p)
+	return nil
+}
+
+// Remove closes the room if nobody is left in it.
+func (r *Registry) Remove(id uint64) {
+	r.mutex.Lock()
+	room, ok := r.rooms[id]
+	if !ok || room.Playback || room.Game.Info().Players > 0 || len(room.seats) > 0 {
+		r.mutex.Unlock()
+		return
+	}
+	delete(r.rooms, id)
+	if room.Code != "" {
+		delete(r.codes, room.Code)
+	}
+	r.mutex.Unlock()
+
+	room.Game.Close()
+}
+
+// Cleanup closes every room without players, including rooms created but never joined.
+func (r *Registry) Cleanup() {
+	r.mutex.Lock()
+	var ids []uint64
+	for id := range r.rooms {
+		ids = append(ids, id)
+	}
+	r.mutex.Unlock()
+
+	for _, id := range ids {
+		r.Remove(id)
+	}
+}
+
+func (r *Registry) Janitor(interval time.Duration) {
+	for range time.Tick(interval) {
+		r.Cleanup()
+	}
+}
+
+// sorted expects r.mutex to be held.
+func (r *Registry) sorted() []*Room {
+	list := make([]*Room, 0, len(r.rooms))
+	for _, room := range r.rooms {
+		list = append(list, room)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+func (r *Registry) record(room *Room, res games.Result) {
+	ctx := context.Background()
+	name := fmt.Sprintf("room%d-%d.replay", room.ID, res.Started.Unix())
+	if r.Matches != nil {
+		id, err := r.Matches.SaveMatch(ctx, res)
+		if err != nil {
+			log.Println("room", room.ID, "save match:", err)
+		} else {
+			room.Game.SetPlayID(uint64(id))
+			name = fmt.Sprintf("%d.replay", id)
+		}
+	}
+	if r.Replays != "" && res.Replay != nil {
+		if err := res.Replay.Save(filepath.Join(r.Replays, name)); err != nil {
+			log.Println("room", room.ID, "replay:", err)
+		}
+	}
+	if r.Progress != nil {
+		if err := r.Progress.AwardExperience(ctx, res); err != nil {
+			log.Println("room", room.ID, "experience:", err)
+		}
+	}
+	if r.Ratings == nil {
+		return
+	}
+	if err := r.Ratings.RecordResult(ctx, res); err != nil {
+		log.Println("room", room.ID, "rating:", err)
+	}
+}
@@ -0,0 +1,316 @@
+package lobby
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"app/object/games"
+	"app/protocol"
+)
+
+var (
+	ErrAlreadyQueued = protocol.Error{Code: protocol.ErrCodeQueued, Message: "already in the queue"}
+	ErrNoMatchmaking = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "matchmaking is disabled"}
+	ErrNotMatched    = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "no seat for you in that room"}
+)
+
+type MatchConfig struct {
+	Window    int           // 처음 허용하는 레이팅 차이
+	Widen     int           // 기다린 1초마다 넓어지는 폭
+	MaxWindow int           //
+	Timeout   time.Duration // 이만큼 못 찾으면 큐에서 뺀다
+	Accept    time.Duration // 매칭 후 방에 들어오기까지
+}
+
+var Matchmaking = MatchConfig{
+	Window:    100,
+	Widen:     20,
+	MaxWindow: 600,
+	Timeout:   3 * time.Minute,
+	Accept:    20 * time.Second,
+}
+
+const DefaultRating = 1000
+
+type RatingStore interface {
+	Rating(ctx context.Context, name string, role byte) (int, error) // role NoTeam : overall
+	Ratings(ctx context.Context, name string, limit int) (protocol.Ratings, error)
+	RecordResult(ctx context.Context, res games.Result) error
+}
+
+// Ticket is one client waiting in the matchmaking queue.
+type Ticket struct {
+	Name   string
+	Role   byte // 원하는 팀, NoTeam 이면 아무거나
+	Rating int
+
+	conn   protocol.Conn
+	queued time.Time
+	room   *Room // 매칭되면 정해진다
+	team   byte
+}
+
+func (t *Ticket) window(now time.Time) int {
+	w := Matchmaking.Window + int(now.Sub(t.queued)/time.Second)*Matchmaking.Widen
+	if w > Matchmaking.MaxWindow {
+		w = Matchmaking.MaxWindow
+	}
+	return w
+}
+
+func (t *Ticket) status(state byte, now time.Time) protocol.QueueStatus {
+	s := protocol.QueueStatus{
+		State:  state,
+		Rating: uint16(t.Rating),
+		Window: uint16(t.window(now)),
+		Waited: uint16(now.Sub(t.queued) / time.Second),
+		Team:   t.team,
+	}
+	if t.room != nil {
+		s.Room = t.room.ID
+	}
+	return s
+}
+
+// Matcher groups queued clients into 3-player rooms of close rating, one per team.
+type Matcher struct {
+	rooms *Registry
+
+	mutex sync.Mutex
+	queue []*Ticket
+}
+
+func NewMatcher(rooms *Registry) *Matcher {
+	return &Matcher{rooms: rooms}
+}
+
+func (m *Matcher) Enqueue(c protocol.Conn, q protocol.Queue) (*Ticket, error) {
+	rating := DefaultRating
+	if m.rooms.Ratings != nil {
+		r, err := m.rooms.Ratings.Rating(context.Background(), q.Name, q.Role)
+		if err != nil {
+			log.Println(err)
+		} else {
+			rating = r
+		}
+	}
+
+	m.mutex.Lock()
+	for _, t := range m.queue {
+		if t.Name == q.Name {
+			m.mutex.Unlock()
+			return nil, ErrAlreadyQueued
+		}
+	}
+	t := &Ticket{
+		Name:   q.Name,
+		Role:   q.Role,
+		Rating: rating,
+		conn:   c,
+		queued: time.Now(),
+		team:   protocol.NoTeam,
+	}
+	m.queue = append(m.queue, t)
+	status := t.status(protocol.QueueWaiting, t.queued)
+	m.mutex.Unlock()
+
+	send(c, status.Encode())
+	return t, nil
+}
+
+// Cancel takes t out of the queue. It reports false if t was already matched or dropped.
+func (m *Matcher) Cancel(t *Ticket) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.remove(t)
+}
+
+// Room returns the room t was matched into, or nil.
+func (m *Matcher) Room(t *Ticket) *Room {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return t.room
+}
+
+func (m *Matcher) Run(interval time.Duration) {
+	for now := range time.Tick(interval) {
+		m.match(now)
+		m.rooms.releaseSeats(now)
+	}
+}
+
+// notice is a queue status waiting to be sent once m.mutex is released.
+type notice struct {
+	conn   protocol.Conn
+	status protocol.QueueStatus
+}
+
+func (m *Matcher) match(now time.Time) {
+	// 느린 연결 하나가 큐 전체를 잡고 있지 않도록 보내기는 잠금 밖에서
+	for _, n := range m.pair(now) {
+		send(n.conn, n.status.Encode())
+	}
+}
+
+// pair groups what it can and returns the status every ticket it touched should get.
+func (m *Matcher) pair(now time.Time) []notice {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var out []notice
+	for _, t := range append([]*Ticket(nil), m.queue...) {
+		if now.Sub(t.queued) > Matchmaking.Timeout {
+			m.remove(t)
+			out = append(out, notice{t.conn, t.status(protocol.QueueTimeout, now)})
+		}
+	}
+
+	// 오래 기다린 순서로 기준을 잡는다
+	for i := 0; i < len(m.queue); i++ {
+		group, teams := m.best(m.queue[i], now)
+		if group == nil {
+			continue
+		}
+		seats := map[string]byte{}
+		for j, t := range group {
+			seats[t.Name] = teams[j]
+		}
+		room := m.rooms.reserve(seats, now.Add(Matchmaking.Accept))
+		for j, t := range group {
+			m.remove(t)
+			t.room = room
+			t.team = teams[j]
+			out = append(out, notice{t.conn, t.status(protocol.QueueMatched, now)})
+		}
+		log.Println("match", room.ID, group[0].Name, group[1].Name, group[2].Name)
+		i = -1
+	}
+
+	for _, t := range m.queue {
+		out = append(out, notice{t.conn, t.status(protocol.QueueWaiting, now)})
+	}
+	return out
+}
+
+// best finds the two partners for anchor with the smallest rating spread.
+func (m *Matcher) best(anchor *Ticket, now time.Time) ([]*Ticket, [3]byte) {
+	var group []*Ticket
+	var teams [3]byte
+	spread := -1
+	for j := 0; j < len(m.queue); j++ {
+		b := m.queue[j]
+		if b == anchor || !accepts(anchor, b, now) {
+			continue
+		}
+		for k := j + 1; k < len(m.queue); k++ {
+			c := m.queue[k]
+			if c == anchor || !accepts(anchor, c, now) || !accepts(b, c, now) {
+				continue
+			}
+			cand := []*Ticket{anchor, b, c}
+			t, ok := assignTeams(cand)
+			if !ok {
+				continue
+			}
+			s := ratingSpread(cand)
+			if spread < 0 || s < spread {
+				group, teams, spread = cand, t, s
+			}
+		}
+	}
+	return group, teams
+}
+
+// 두 사람 모두의 범위 안에 들어야 한다
+func accepts(a, b *Ticket, now time.Time) bool {
+	d := a.Rating - b.Rating
+	if d < 0 {
+		d = -d
+	}
+	return d <= a.window(now) && d <= b.window(now)
+}
+
+func ratingSpread(group []*Ticket) int {
+	min, max := group[0].Rating, group[0].Rating
+	for _, t := range group[1:] {
+		if t.Rating < min {
+			min = t.Rating
+		}
+		if t.Rating > max {
+			max = t.Rating
+		}
+	}
+	return max - min
+}
+
+// assignTeams gives every ticket a distinct team, honouring role preferences.
+func assignTeams(group []*Ticket) ([3]byte, bool) {
+	perms := [][3]byte{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	for _, teams := range perms {
+		ok := true
+		for i, t := range group {
+			if t.Role != protocol.NoTeam && t.Role != teams[i] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return teams, true
+		}
+	}
+	return [3]byte{}, false
+}
+
+// remove expects m.mutex to be held.
+func (m *Matcher) remove(t *Ticket) bool {
+	for i, other := range m.queue {
+		if other == t {
+			m.queue = append(m.queue[:i], m.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// reserve creates a hidden room holding a seat for each name until deadline.
+func (r *Registry) reserve(seats map[string]byte, deadline time.Time) *Room {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	room := r.create("match", "", true)
+	room.seats = seats
+	room.seatsUntil = deadline
+	return room
+}
+
+// Seat puts c into the seat the matcher kept for name, which it logged in as.
+func (r *Registry) Seat(room *Room, name string, c protocol.Conn, reply protocol.HelloReply) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	team, ok := room.seats[name]
+	if !ok || r.rooms[room.ID] != room {
+		return ErrNotMatched
+	}
+	delete(room.seats, name)
+	joined := protocol.RoomJoined{ID: room.ID}.Encode()
+	return room.Game.Seat(c, reply, name, team, joined)
+}
+
+// releaseSeats opens match rooms whose players did not all show up in time to everyone.
+func (r *Registry) releaseSeats(now time.Time) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, room := range r.rooms {
+		if room.seats == nil || now.Before(room.seatsUntil) {
+			continue
+		}
+		if len(room.seats) > 0 {
+			log.Println("match room opened", room.ID)
+			room.Private = false
+			delete(r.codes, room.Code)
+			room.Code = ""
+		}
+		room.seats = nil
+	}
+}
@@ -0,0 +1,145 @@
+package lobby
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"app/object/games"
+	"app/protocol"
+)
+
+// 로비에서 아무 메시지도 없을 때 끊기까지
+var IdleTimeout = 5 * time.Minute
+
+var (
+	ErrNoAccounts = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "accounts are disabled"}
+	ErrLoginFirst = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "log in as that name first"}
+)
+
+// Serve answers lobby messages on a freshly negotiated connection until it enters a room.
+// From then on the room's player handler owns the connection.
+func (r *Registry) Serve(c protocol.Conn, hello protocol.Hello, reply protocol.HelloReply) {
+	var ticket *Ticket
+	var account string // Login 으로 확인한 이름
+	defer func() {
+		if ticket != nil {
+			r.Matcher.Cancel(ticket)
+		}
+	}()
+
+	for {
+		c.SetReadDeadline(time.Now().Add(IdleTimeout))
+		data, err := c.ReadFrame()
+		if err != nil {
+			log.Println(err)
+			c.Close()
+			return
+		}
+		msg, err := protocol.Decode(data)
+		if err != nil {
+			send(c, protocol.Error{Code: protocol.ErrCodeBadRequest, Message: err.Error()}.Encode())
+			continue
+		}
+
+		switch m := msg.(type) {
+		case protocol.ListRooms:
+			err = send(c, r.Browse().Encode())
+			if err != nil {
+				log.Println(err)
+				c.Close()
+				return
+			}
+			continue
+		case protocol.CreateRoom:
+			_, err = r.CreateAndJoin(m, c, reply, hello.Role, account)
+		case protocol.JoinRoom:
+			if room := r.matched(ticket); room != nil && room.ID == m.ID {
+				err = r.Seat(room, ticket.Name, c, reply)
+			} else if m.ID == 0 && hello.Role == protocol.RoleSpectator {
+				_, err = r.Spectate(c, reply)
+			} else if m.ID == 0 {
+				_, err = r.QuickJoin(c, reply, account)
+			} else {
+				_, err = r.Join(m.ID, m.Password, c, reply, hello.Role, account)
+			}
+		case protocol.JoinCode:
+			_, err = r.JoinCode(m.Code, c, reply, hello.Role, account)
+		case protocol.Login:
+			if r.Accounts == nil {
+				err = ErrNoAccounts
+				break
+			}
+			if err = r.Accounts.Login(context.Background(), m.Name, m.Secret); err == nil {
+				account = m.Name
+				send(c, protocol.LoggedIn{Name: account}.Encode())
+				continue
+			}
+		case protocol.Queue:
+			if r.Matcher == nil {
+				err = ErrNoMatchmaking
+				break
+			}
+			if r.Accounts != nil && m.Name != account {
+				err = ErrLoginFirst
+				break
+			}
+			if hello.Role == protocol.RoleSpectator {
+				err = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "spectators cannot queue"}
+				break
+			}
+			if ticket != nil { // 역할을 바꿔 다시 줄 선다
+				r.Matcher.Cancel(ticket)
+			}
+			if ticket, err = r.Matcher.Enqueue(c, m); err == nil {
+				continue
+			}
+		case protocol.CancelQueue:
+			if ticket != nil && r.Matcher.Cancel(ticket) {
+				send(c, protocol.QueueStatus{State: protocol.QueueCancelled, Team: protocol.NoTeam}.Encode())
+			}
+			ticket = nil
+			continue
+		case protocol.RatingsRequest:
+			if r.Ratings == nil {
+				err = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "ratings are disabled"}
+				break
+			}
+			var ratings protocol.Ratings
+			if ratings, err = r.Ratings.Ratings(context.Background(), m.Name, protocol.RatingHistoryLength); err == nil {
+				send(c, ratings.Encode())
+				continue
+			}
+		case protocol.CardsRequest:
+			send(c, games.CurrentCatalog().CardList().Encode())
+			continue
+		case protocol.Pong:
+			continue
+		default:
+			err = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "join a room first"}
+		}
+
+		if err == nil {
+			return
+		}
+		e, ok := err.(protocol.Error)
+		if !ok {
+			e = protocol.Error{Message: err.Error()}
+		}
+		send(c, e.Encode())
+	}
+}
+
+// send writes one lobby frame. Welcome's write deadline has long passed by
+// then, so every write gets a fresh one.
+func send(c protocol.Conn, data []byte) error {
+	c.SetWriteDeadline(time.Now().Add(games.Heartbeat.WriteTimeout))
+	return c.WriteFrame(data)
+}
+
+func (r *Registry) matched(t *Ticket) *Room {
+	if t == nil {
+		return nil
+	}
+	return r.Matcher.Room(t)
+}
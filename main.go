@@ -2,23 +2,53 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"net"
+	"path/filepath"
 	"runtime"
+	"time"
 
+	"app/admin"
 	"app/ent"
+	"app/gateway"
+	"app/lobby"
 	g "app/object/games"
+	"app/protocol"
+	"app/store"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
-var games []*g.Game
+var rooms = lobby.NewRegistry()
+
+var (
+	wsAddr = flag.String("ws", "", "websocket listen address, e.g. :30005 (disabled if empty)")
+	dbDSN  = flag.String("db", "file:ent?mode=memory&cache=shared&_fk=1", "sqlite3 DSN, e.g. file:app.db?_fk=1")
+	replay = flag.String("replays", "", "directory to write match replays to (disabled if empty)")
+	play   = flag.String("play", "", "replay file to host as a watch-only room")
+	rate   = flag.Int("sendrate", g.Tick.SendRate, "state updates sent to clients per second, a divisor of 60 (1, 2, 3, 4, 5, 6, 10, 12, 15, 20, 30, 60)")
+	cards  = flag.String("catalog", "", "JSON card and unit catalog (built-in default if empty)")
+	admins = flag.String("admin", "", "admin HTTP listen address, keep it private, e.g. 127.0.0.1:30006 (disabled if empty)")
+)
 
 func main() {
+	flag.Parse()
+	g.Tick.SendRate = *rate
+	if err := g.Tick.Validate(); err != nil {
+		log.Fatalf("invalid -sendrate: %v", err)
+	}
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
-	// todo : init DB
-	client, err := ent.Open("sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	if *cards != "" {
+		c, err := g.LoadCatalog(*cards)
+		if err != nil {
+			log.Fatalf("failed loading catalog: %v", err)
+		}
+		g.UseCatalog(c)
+	}
+
+	client, err := ent.Open("sqlite3", *dbDSN)
 	if err != nil {
 		log.Fatalf("failed opening connection to sqlite: %v", err)
 	}
@@ -28,6 +58,47 @@ func main() {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	db := store.New(client)
+	rooms.Ratings = db
+	rooms.Matches = db
+	rooms.Decks = db
+	rooms.Collection = db
+	rooms.Progress = db
+	rooms.Accounts = db
+	rooms.Replays = *replay
+	rooms.Matcher = lobby.NewMatcher(rooms)
+	go rooms.Matcher.Run(time.Second)
+	go rooms.Janitor(time.Minute)
+
+	if *play != "" {
+		rep, err := g.LoadReplay(*play)
+		if err != nil {
+			log.Fatalf("failed loading replay: %v", err)
+		}
+		if _, err := rooms.Playback(filepath.Base(*play), rep); err != nil {
+			log.Fatalf("failed playing replay: %v", err)
+		}
+	}
+
+	if *admins != "" {
+		go func() {
+			log.Println("Admin Open " + *admins)
+			srv := &admin.Server{Catalog: *cards, Cards: db, OnRevoke: rooms.Revoke}
+			if err := srv.ListenAndServe(*admins); err != nil {
+				log.Println(err)
+			}
+		}()
+	}
+
+	if *wsAddr != "" {
+		go func() {
+			log.Println("WebSocket Open " + *wsAddr)
+			if err := gateway.ListenWebSocket(*wsAddr, accept); err != nil {
+				log.Println(err)
+			}
+		}()
+	}
+
 	l, err := net.Listen("tcp", ":30004")
 	if err != nil {
 		log.Println(err)
@@ -42,20 +113,20 @@ func main() {
 		if err != nil {
 			log.Println(err)
 		} else {
-			if len(games) == 0 {
-				games = append(games, g.NewGame())
-			}
-			isJoin := false
-			for _, g := range games {
-				if g.PlayerCount < 3 {
-					g.Join(conn)
-					isJoin = true
-				}
-			}
-			if !isJoin {
-				games = append(games, g.NewGame())
-				games[len(games)-1].Join(conn)
-			}
+			go accept(protocol.NewStreamConn(conn))
 		}
 	}
 }
+
+func accept(conn protocol.Conn) {
+	hello, reply, err := g.Welcome(conn)
+	if err != nil {
+		log.Println(err)
+		conn.Close()
+		return
+	}
+	if g.Resume(hello.Session, conn, reply) {
+		return
+	}
+	rooms.Serve(conn, hello, reply)
+}
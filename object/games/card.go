@@ -2,8 +2,12 @@ package games
 
 import (
 	"encoding/binary"
+	"fmt"
 	"log"
+	"sort"
 	"strconv"
+
+	"app/protocol"
 )
 
 type ICard interface {
@@ -18,6 +22,10 @@ type Card struct {
 	cost     uint16
 	overcost uint16
 
+	name   string
+	rarity protocol.Rarity
+	teams  byte // 쓸 수 있는 팀, bit 마다 하나
+
 	width       float64
 	height      float64
 	isCollision bool
@@ -88,148 +96,95 @@ func (c Card) Data() []byte {
 	return data
 }
 
-var CardList []Card = []Card{
-	{},
-	CardFlask(),
-	CardNote(),
-	CardBigPencil(),
-	CardPaint(),
-	CardErasers(),
-	CardSharpener(),
-	CardBag(),
-	CardAlarm(),
-	CardDictionary(),
-	CardPaintBrush(),
-}
-
-func CardFlask() Card {
-	flask := Card{}
-	flask.id = 1
-	flask.cost = 5
-	flask.width = 1.62
-	flask.height = 2.71
-	flask.isCollision = true
-	flask.spawnList = append(flask.spawnList, func() interface{} {
-		return NewFlask()
-	})
-	return flask
-}
-
-func CardNote() Card {
-	note := Card{}
-	note.id = 2
-	note.cost = 7
-	note.width = 2.25
-	note.height = 2.92
-	note.isCollision = true
-	note.spawnList = append(note.spawnList, func() interface{} {
-		return NewNote()
-	})
-	return note
-}
-
-func CardBigPencil() Card {
-	bigPencil := Card{}
-	bigPencil.id = 3
-	bigPencil.cost = 5
-	bigPencil.width = 6.52
-	bigPencil.height = 1.49
-	bigPencil.isCollision = true
-	bigPencil.spawnList = append(bigPencil.spawnList, func() interface{} {
-		return NewBigPencil()
-	})
-	return bigPencil
-}
-
-func CardPaint() Card {
-	hm := *NewHealMagic(100, 5)
-	paint := Card{}
-	paint.id = 4
-	paint.cost = 3
-	paint.spawnList = append(paint.spawnList, func() interface{} {
-		return NewThrower(3, 10, hm)
-	})
-	return paint
-}
-
-func CardErasers() Card {
-	dm := *NewDamageMagic(100, 4)
-	erasers := Card{}
-	erasers.id = 5
-	erasers.cost = 2
-	erasers.spawnList = append(erasers.spawnList, func() interface{} {
-		return NewThrower(3, 11, dm)
-	})
-	erasers.spawnList = append(erasers.spawnList, func() interface{} {
-		return NewThrower(4, 11, dm)
-	})
-	erasers.spawnList = append(erasers.spawnList, func() interface{} {
-		return NewThrower(5, 11, dm)
-	})
-	return erasers
-}
-
-func CardSharpener() Card {
-	sharpener := Card{}
-	sharpener.id = 6
-	sharpener.cost = 6
-	sharpener.width = 3.49
-	sharpener.height = 2.34
-	sharpener.isCollision = true
-	sharpener.spawnList = append(sharpener.spawnList, func() interface{} {
-		return NewSharpener()
-	})
-	return sharpener
-}
-
-func CardBag() Card {
-	bag := Card{}
-	bag.id = 7
-	bag.cost = 4
-	bag.width = 3.07
-	bag.height = 3.59
-	bag.isCollision = true
-	bag.spawnList = append(bag.spawnList, func() interface{} {
-		return NewBag()
-	})
-	return bag
-}
-
-func CardAlarm() Card {
-	alarm := Card{}
-	alarm.id = 8
-	alarm.cost = 2
-	alarm.width = 1.69
-	alarm.height = 1.96
-	alarm.isCollision = true
-	alarm.spawnList = append(alarm.spawnList, func() interface{} {
-		return NewAlarm()
-	})
-	return alarm
-}
-
-func CardDictionary() Card {
-	dictionary := Card{}
-	dictionary.id = 9
-	dictionary.cost = 8
-	dictionary.width = 1.06
-	dictionary.height = 3.04
-	dictionary.isCollision = true
-	dictionary.spawnList = append(dictionary.spawnList, func() interface{} {
-		return NewDictionary()
-	})
-	return dictionary
-}
-
-func CardPaintBrush() Card {
-	paintBrush := Card{}
-	paintBrush.id = 10
-	paintBrush.cost = 3
-	paintBrush.width = 4.08
-	paintBrush.height = 1.29
-	paintBrush.isCollision = true
-	paintBrush.spawnList = append(paintBrush.spawnList, func() interface{} {
-		return NewPaintBrush()
-	})
-	return paintBrush
+func (c Card) ID() uint32 {
+	return c.id
+}
+
+func (c Card) Name() string {
+	return c.name
+}
+
+func (c Card) Rarity() protocol.Rarity {
+	return c.rarity
+}
+
+func (c Card) AllowedFor(team byte) bool {
+	return team >= protocol.TeamCount || c.teams&(1<<team) != 0
+}
+
+func (c Card) Info() protocol.CardInfo {
+	return protocol.CardInfo{
+		ID:     c.id,
+		Name:   c.name,
+		Cost:   c.cost,
+		Rarity: c.rarity,
+		Teams:  c.teams,
+	}
+}
+
+// CardRegistry holds the cards of one catalog by id.
+type CardRegistry struct {
+	cards map[uint32]Card
+	ids   []uint32 // 오름차순
+}
+
+func NewCardRegistry() *CardRegistry {
+	return &CardRegistry{cards: map[uint32]Card{}}
+}
+
+func (r *CardRegistry) Add(c Card) error {
+	if c.id == 0 {
+		return fmt.Errorf("card id must be positive")
+	}
+	if _, ok := r.cards[c.id]; ok {
+		return fmt.Errorf("card %d already registered", c.id)
+	}
+	r.cards[c.id] = c
+	i := sort.Search(len(r.ids), func(i int) bool { return r.ids[i] > c.id })
+	r.ids = append(r.ids, 0)
+	copy(r.ids[i+1:], r.ids[i:])
+	r.ids[i] = c.id
+	return nil
+}
+
+// Get returns a fresh copy of the card, safe to change the cost of.
+func (r *CardRegistry) Get(id uint32) (Card, bool) {
+	c, ok := r.cards[id]
+	return c, ok
+}
+
+func (r *CardRegistry) Has(id uint32) bool {
+	_, ok := r.cards[id]
+	return ok
+}
+
+func (r *CardRegistry) Len() int {
+	return len(r.ids)
+}
+
+// All returns every card ordered by id.
+func (r *CardRegistry) All() []Card {
+	cards := make([]Card, len(r.ids))
+	for i, id := range r.ids {
+		cards[i] = r.cards[id]
+	}
+	return cards
+}
+
+// Check tells why ids cannot be used as a deck by team, or returns nil.
+// An empty slot is id 0; team NoTeam skips the team restrictions.
+func (r *CardRegistry) Check(ids []uint32, team byte) error {
+	for _, id := range ids {
+		if id == 0 {
+			continue
+		}
+		c, ok := r.cards[id]
+		if !ok {
+			return protocol.Error{Code: protocol.ErrCodeBadDeck, Message: fmt.Sprintf("unknown card %d", id)}
+		}
+		if !c.AllowedFor(team) {
+			return protocol.Error{Code: protocol.ErrCodeBadDeck, Message: fmt.Sprintf("card %d (%s) cannot be used by team %d", id, c.name, team)}
+		}
+	}
+	return nil
 }
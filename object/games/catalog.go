@@ -0,0 +1,484 @@
+package games
+
+import (
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"app/protocol"
+)
+
+// 기본 카드/유닛 표, -catalog 로 다른 파일을 줄 수 있다
+//
+//go:embed catalog.json
+var defaultCatalog []byte
+
+// UnitDef holds the stats of one unit kind. Behavior picks the Go code that
+// drives it; the fields a behavior does not use are ignored.
+type UnitDef struct {
+	Name     string  `json:"name"`
+	Type     uint16  `json:"type"` // 클라이언트가 그릴 모양
+	Behavior string  `json:"behavior"`
+	Health   uint32  `json:"health"`
+	Width    float64 `json:"width"`
+	Height   float64 `json:"height"`
+	X        float64 `json:"x,omitempty"`
+
+	Damage   uint32  `json:"damage,omitempty"`
+	Heal     uint32  `json:"heal,omitempty"`
+	Percent  float64 `json:"percent,omitempty"`  // 받은 피해 중 회복으로 돌리는 비율
+	Cooldown uint8   `json:"cooldown,omitempty"` // frame
+	Wait     uint8   `json:"wait,omitempty"`     // frame
+	Distance float64 `json:"distance,omitempty"` // 감지 거리
+	Radius   uint32  `json:"radius,omitempty"`   // 마법 범위
+	Energy   uint16  `json:"energy,omitempty"`
+	Period   int     `json:"period,omitempty"` // frame
+
+	Bullet *BulletDef `json:"bullet,omitempty"`
+}
+
+type BulletDef struct {
+	Type   uint16  `json:"type"`
+	Damage uint32  `json:"damage"`
+	Speed  float64 `json:"speed"`
+	Life   uint16  `json:"life"` // frame
+}
+
+type MagicDef struct {
+	Kind   string `json:"kind"` // damage, heal
+	Amount uint32 `json:"amount"`
+	Radius uint32 `json:"radius"`
+}
+
+type ProjectileDef struct {
+	Name     string    `json:"name"`
+	Type     uint16    `json:"type"`
+	Behavior string    `json:"behavior"`
+	Height   float64   `json:"height"`
+	Magic    *MagicDef `json:"magic,omitempty"`
+}
+
+// SpawnDef names either a unit or a projectile; Height overrides the
+// projectile's drop height when set.
+type SpawnDef struct {
+	Unit       string  `json:"unit,omitempty"`
+	Projectile string  `json:"projectile,omitempty"`
+	Height     float64 `json:"height,omitempty"`
+}
+
+type CardDef struct {
+	ID        uint32     `json:"id"`
+	Name      string     `json:"name"`
+	Rarity    string     `json:"rarity,omitempty"` // common, rare, epic, legendary
+	Teams     []byte     `json:"teams,omitempty"`  // 비우면 모든 팀
+	Cost      uint16     `json:"cost"`
+	Width     float64    `json:"width,omitempty"`
+	Height    float64    `json:"height,omitempty"`
+	Collision bool       `json:"collision,omitempty"`
+	Spawn     []SpawnDef `json:"spawn"`
+
+	Starter  bool `json:"starter,omitempty"`   // 처음부터 가지고 있는 카드
+	UnlockXP int  `json:"unlock_xp,omitempty"` // 경험치가 이만큼 쌓이면 얻는다, 0 이면 경험치로 얻지 못한다
+}
+
+// Progression is how much experience a finished match gives each named player.
+type Progression struct {
+	MatchXP int `json:"match_xp"`
+	WinXP   int `json:"win_xp"` // 이긴 팀에게 더
+}
+
+type Catalog struct {
+	Version     string          `json:"version,omitempty"` // 비우면 내용으로 정한다
+	Cards       []CardDef       `json:"cards"`
+	Units       []UnitDef       `json:"units"`
+	Projectiles []ProjectileDef `json:"projectiles"`
+	Deck        DeckRules       `json:"deck"`
+	Progression Progression     `json:"progression"`
+
+	units       map[string]*UnitDef
+	projectiles map[string]*ProjectileDef
+	earth       *UnitDef
+	cards       *CardRegistry
+}
+
+var unitBehaviors = map[string]func(*UnitDef) interface{}{
+	"earth":      func(d *UnitDef) interface{} { return NewEarth(d) },
+	"flask":      func(d *UnitDef) interface{} { return NewFlask(d) },
+	"note":       func(d *UnitDef) interface{} { return NewNote(d) },
+	"bag":        func(d *UnitDef) interface{} { return NewBag(d) },
+	"pen":        func(d *UnitDef) interface{} { return NewPen(d) },
+	"bigpencil":  func(d *UnitDef) interface{} { return NewBigPencil(d) },
+	"sharpener":  func(d *UnitDef) interface{} { return NewSharpener(d) },
+	"alarm":      func(d *UnitDef) interface{} { return NewAlarm(d) },
+	"dictionary": func(d *UnitDef) interface{} { return NewDictionary(d) },
+	"paintbrush": func(d *UnitDef) interface{} { return NewPaintBrush(d) },
+}
+
+var rarities = map[string]protocol.Rarity{
+	"":          protocol.RarityCommon,
+	"common":    protocol.RarityCommon,
+	"rare":      protocol.RarityRare,
+	"epic":      protocol.RarityEpic,
+	"legendary": protocol.RarityLegendary,
+}
+
+// CatalogError lists every problem found in a catalog, one per line.
+type CatalogError []string
+
+func (e CatalogError) Error() string {
+	return "invalid catalog:\n  " + strings.Join(e, "\n  ")
+}
+
+func (e *CatalogError) add(format string, a ...interface{}) {
+	*e = append(*e, fmt.Sprintf(format, a...))
+}
+
+// 대기 중인 방이 쓰는 최신 표, 게임은 시작할 때 잡은 표를 끝까지 쓴다
+var current atomic.Value // *Catalog
+
+func init() {
+	current.Store(DefaultCatalog())
+}
+
+func mustCatalog(data []byte) *Catalog {
+	c, err := ParseCatalog(data)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+func DefaultCatalog() *Catalog {
+	return mustCatalog(defaultCatalog)
+}
+
+func LoadCatalog(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c, err := ParseCatalog(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+// ParseCatalog decodes and validates a JSON catalog. Unknown fields are
+// rejected so a misspelt stat does not silently fall back to zero.
+func ParseCatalog(data []byte) (*Catalog, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	c := &Catalog{}
+	if err := dec.Decode(c); err != nil {
+		return nil, CatalogError{err.Error()}
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	if c.Version == "" {
+		sum := sha256.Sum256(data)
+		c.Version = hex.EncodeToString(sum[:6])
+	}
+	c.build()
+	return c, nil
+}
+
+func CurrentCatalog() *Catalog {
+	return current.Load().(*Catalog)
+}
+
+// UseCatalog makes c the catalog for every match that starts from now on.
+func UseCatalog(c *Catalog) {
+	current.Store(c)
+}
+
+// ReloadCatalog loads path and, if it is valid, swaps it in. Matches already
+// running keep the catalog they started with.
+func ReloadCatalog(path string) (*Catalog, error) {
+	c, err := LoadCatalog(path)
+	if err != nil {
+		return nil, err
+	}
+	old := CurrentCatalog()
+	UseCatalog(c)
+	log.Println("catalog reloaded :", old.Version, "->", c.Version)
+	return c, nil
+}
+
+func (c *Catalog) validate() error {
+	var errs CatalogError
+
+	// 리플레이와 카드 목록에 한 byte 길이로 쓴다
+	if len(c.Version) > math.MaxUint8 {
+		errs.add("version: at most %d bytes, got %d", math.MaxUint8, len(c.Version))
+	}
+
+	c.units = map[string]*UnitDef{}
+	types := map[uint16]string{}
+	for i := range c.Units {
+		u := &c.Units[i]
+		where := fmt.Sprintf("units[%d] (%s)", i, u.Name)
+		if u.Name == "" {
+			errs.add("units[%d]: missing name", i)
+		} else if _, ok := c.units[u.Name]; ok {
+			errs.add("%s: duplicate name", where)
+		} else {
+			c.units[u.Name] = u
+		}
+		if other, ok := types[u.Type]; ok {
+			errs.add("%s: type %d already used by %s", where, u.Type, other)
+		} else {
+			types[u.Type] = u.Name
+		}
+		if _, ok := unitBehaviors[u.Behavior]; !ok {
+			errs.add("%s: unknown behavior %q", where, u.Behavior)
+		}
+		if u.Health == 0 {
+			errs.add("%s: health must be positive", where)
+		}
+		if u.Width <= 0 || u.Height <= 0 {
+			errs.add("%s: size %gx%g must be positive", where, u.Width, u.Height)
+		}
+		switch u.Behavior {
+		case "earth":
+			if c.earth != nil {
+				errs.add("%s: only one earth unit is allowed, already have %s", where, c.earth.Name)
+			}
+			c.earth = u
+		case "flask":
+			if u.Period <= 0 {
+				errs.add("%s: period must be positive", where)
+			}
+		case "bigpencil":
+			if u.Damage == 0 || u.Cooldown == 0 || u.Radius == 0 || u.Distance <= 0 {
+				errs.add("%s: damage, cooldown, radius and distance must be positive", where)
+			}
+		case "alarm":
+			if u.Damage == 0 || u.Radius == 0 {
+				errs.add("%s: damage and radius must be positive", where)
+			}
+		case "dictionary":
+			if u.Radius == 0 {
+				errs.add("%s: radius must be positive", where)
+			}
+			if u.Percent <= 0 || u.Percent > 1 {
+				errs.add("%s: percent must be above 0 and at most 1", where)
+			}
+		case "paintbrush":
+			if u.Cooldown == 0 || u.Heal == 0 || u.Distance <= 0 {
+				errs.add("%s: cooldown, heal and distance must be positive", where)
+			}
+		case "sharpener":
+			if u.Cooldown == 0 || u.Distance <= 0 {
+				errs.add("%s: cooldown and distance must be positive", where)
+			}
+			if u.Bullet == nil {
+				errs.add("%s: missing bullet", where)
+			} else if u.Bullet.Life == 0 || u.Bullet.Speed <= 0 {
+				errs.add("%s: bullet life and speed must be positive", where)
+			}
+		}
+	}
+	if c.earth == nil {
+		errs.add("units: no unit with behavior \"earth\"")
+	}
+
+	c.projectiles = map[string]*ProjectileDef{}
+	for i := range c.Projectiles {
+		p := &c.Projectiles[i]
+		where := fmt.Sprintf("projectiles[%d] (%s)", i, p.Name)
+		if p.Name == "" {
+			errs.add("projectiles[%d]: missing name", i)
+		} else if _, ok := c.projectiles[p.Name]; ok {
+			errs.add("%s: duplicate name", where)
+		} else {
+			c.projectiles[p.Name] = p
+		}
+		if p.Behavior != "thrower" {
+			errs.add("%s: unknown behavior %q", where, p.Behavior)
+		}
+		if p.Height <= 0 {
+			errs.add("%s: height must be positive", where)
+		}
+		if p.Magic != nil && p.Magic.Kind != "damage" && p.Magic.Kind != "heal" {
+			errs.add("%s: unknown magic kind %q", where, p.Magic.Kind)
+		}
+	}
+
+	ids := map[uint32]bool{}
+	for i, card := range c.Cards {
+		where := fmt.Sprintf("cards[%d] (id %d)", i, card.ID)
+		if card.ID == 0 {
+			errs.add("cards[%d]: id must be positive", i)
+		} else if ids[card.ID] {
+			errs.add("%s: duplicate id", where)
+		}
+		ids[card.ID] = true
+		if card.Name == "" {
+			errs.add("%s: missing name", where)
+		}
+		if _, ok := rarities[card.Rarity]; !ok {
+			errs.add("%s: unknown rarity %q", where, card.Rarity)
+		}
+		for _, team := range card.Teams {
+			if team >= protocol.TeamCount {
+				errs.add("%s: team %d does not exist", where, team)
+			}
+		}
+		if card.Collision && (card.Width <= 0 || card.Height <= 0) {
+			errs.add("%s: size %gx%g must be positive", where, card.Width, card.Height)
+		}
+		if len(card.Spawn) == 0 {
+			errs.add("%s: spawns nothing", where)
+		}
+		if card.UnlockXP < 0 {
+			errs.add("%s: unlock_xp must not be negative", where)
+		}
+		for j, s := range card.Spawn {
+			switch {
+			case s.Unit != "" && s.Projectile != "":
+				errs.add("%s: spawn[%d]: set either unit or projectile, not both", where, j)
+			case s.Unit != "":
+				if _, ok := c.units[s.Unit]; !ok {
+					errs.add("%s: spawn[%d]: unknown unit %q", where, j, s.Unit)
+				}
+			case s.Projectile != "":
+				if _, ok := c.projectiles[s.Projectile]; !ok {
+					errs.add("%s: spawn[%d]: unknown projectile %q", where, j, s.Projectile)
+				}
+			default:
+				errs.add("%s: spawn[%d]: set unit or projectile", where, j)
+			}
+			if s.Height < 0 {
+				errs.add("%s: spawn[%d]: height must not be negative", where, j)
+			}
+		}
+	}
+
+	if c.Deck.MaxCopies < 1 {
+		errs.add("deck: max_copies must be at least 1")
+	} else if len(c.Cards)*c.Deck.MaxCopies < protocol.DeckSize {
+		errs.add("deck: %d cards at %d copies each cannot fill a deck of %d", len(c.Cards), c.Deck.MaxCopies, protocol.DeckSize)
+	}
+	if c.Deck.MaxCost < 0 {
+		errs.add("deck: max_cost must not be negative")
+	}
+	starters := 0
+	for _, card := range c.Cards {
+		if card.Starter {
+			starters++
+		}
+	}
+	if c.Deck.MaxCopies >= 1 && starters*c.Deck.MaxCopies < protocol.DeckSize {
+		errs.add("cards: %d starter cards cannot fill a deck of %d", starters, protocol.DeckSize)
+	}
+	if c.Progression.MatchXP < 0 || c.Progression.WinXP < 0 {
+		errs.add("progression: experience must not be negative")
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// build turns the checked definitions into the Card values players hold.
+func (c *Catalog) build() {
+	c.cards = NewCardRegistry()
+	for _, def := range c.Cards {
+		card := Card{
+			id:          def.ID,
+			cost:        def.Cost,
+			name:        def.Name,
+			rarity:      rarities[def.Rarity],
+			width:       def.Width,
+			height:      def.Height,
+			isCollision: def.Collision,
+		}
+		if len(def.Teams) == 0 {
+			card.teams = 1<<protocol.TeamCount - 1
+		}
+		for _, team := range def.Teams {
+			card.teams |= 1 << team
+		}
+		for _, s := range def.Spawn {
+			card.spawnList = append(card.spawnList, c.spawner(s))
+		}
+		c.cards.Add(card) // validate 에서 id 가 겹치지 않는 것을 봤다
+	}
+}
+
+func (c *Catalog) spawner(s SpawnDef) func() interface{} {
+	if s.Unit != "" {
+		u := c.units[s.Unit]
+		newUnit := unitBehaviors[u.Behavior]
+		return func() interface{} {
+			return newUnit(u)
+		}
+	}
+	p := c.projectiles[s.Projectile]
+	height := p.Height
+	if s.Height > 0 {
+		height = s.Height
+	}
+	var m IMagic
+	if p.Magic != nil {
+		switch p.Magic.Kind {
+		case "damage":
+			m = *NewDamageMagic(p.Magic.Amount, p.Magic.Radius)
+		case "heal":
+			m = *NewHealMagic(p.Magic.Amount, p.Magic.Radius)
+		}
+	}
+	return func() interface{} {
+		return NewThrower(height, p.Type, m)
+	}
+}
+
+func (c *Catalog) Registry() *CardRegistry {
+	return c.cards
+}
+
+// Starters are the cards every new player and every guest owns.
+func (c *Catalog) Starters() []uint32 {
+	var ids []uint32
+	for _, card := range c.Cards {
+		if card.Starter {
+			ids = append(ids, card.ID)
+		}
+	}
+	return ids
+}
+
+// Unlocks are the cards experience grants when it rises from from to to.
+func (c *Catalog) Unlocks(from, to int) []uint32 {
+	var ids []uint32
+	for _, card := range c.Cards {
+		if card.UnlockXP > 0 && from < card.UnlockXP && card.UnlockXP <= to {
+			ids = append(ids, card.ID)
+		}
+	}
+	return ids
+}
+
+// CardList describes every card for clients building a deck.
+func (c *Catalog) CardList() protocol.CardList {
+	l := protocol.CardList{Version: c.Version}
+	for _, card := range c.cards.All() {
+		l.Cards = append(l.Cards, card.Info())
+	}
+	return l
+}
+
+func (c *Catalog) NewEarth() *Earth {
+	return NewEarth(c.earth)
+}
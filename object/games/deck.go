@@ -0,0 +1,216 @@
+package games
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"app/protocol"
+)
+
+// DeckRules limit what a deck may hold; the catalog carries them.
+type DeckRules struct {
+	MaxCopies int `json:"max_copies"`         // 같은 카드 최대 장수
+	MaxCost   int `json:"max_cost,omitempty"` // 코스트 합, 0 이면 제한 없음
+}
+
+// DeckStore keeps named decks by the name their owner logged in as. The lobby sets Game.Decks.
+type DeckStore interface {
+	SaveDeck(ctx context.Context, owner string, d protocol.SavedDeck) (protocol.SavedDeck, error)
+	Decks(ctx context.Context, owner string) ([]protocol.SavedDeck, error)
+	Deck(ctx context.Context, owner string, id uint32) (protocol.SavedDeck, error)
+}
+
+var (
+	ErrNoDeckStore  = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "saved decks are disabled"}
+	ErrNoName       = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "log in to use saved decks"}
+	ErrNoCollection = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "card collections are disabled"}
+)
+
+func badDeck(format string, a ...interface{}) error {
+	return protocol.Error{Code: protocol.ErrCodeBadDeck, Message: fmt.Sprintf(format, a...)}
+}
+
+// CheckDeck tells why ids is not a legal deck for team under c, or returns nil.
+// Team NoTeam skips the per-team card pools.
+func (c *Catalog) CheckDeck(ids []uint32, team byte) error {
+	if len(ids) != protocol.DeckSize {
+		return badDeck("deck needs exactly %d cards, got %d", protocol.DeckSize, len(ids))
+	}
+	for i, id := range ids {
+		if id == 0 {
+			return badDeck("slot %d is empty", i+1)
+		}
+	}
+	if err := c.cards.Check(ids, team); err != nil {
+		return err
+	}
+	copies := map[uint32]int{}
+	cost := 0
+	for _, id := range ids {
+		card, _ := c.cards.Get(id)
+		copies[id]++
+		if copies[id] > c.Deck.MaxCopies {
+			return badDeck("at most %d of card %d (%s) allowed", c.Deck.MaxCopies, id, card.name)
+		}
+		cost += int(card.cost)
+	}
+	if c.Deck.MaxCost > 0 && cost > c.Deck.MaxCost {
+		return badDeck("deck costs %d, at most %d allowed", cost, c.Deck.MaxCost)
+	}
+	return nil
+}
+
+// CollectionStore reports the cards a player owns. The lobby sets Game.Collection;
+// when it is nil every card may be used.
+type CollectionStore interface {
+	Collection(ctx context.Context, owner string) (protocol.Collection, error)
+}
+
+var ErrSpectatorDeck = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "spectators cannot play"}
+
+// collection is account's collection; a guest owns the starter cards.
+func collection(ctx context.Context, store CollectionStore, account string) (protocol.Collection, error) {
+	if account == "" {
+		return protocol.Collection{Cards: CurrentCatalog().Starters()}, nil
+	}
+	return store.Collection(ctx, account)
+}
+
+func ownedSet(col protocol.Collection) map[uint32]bool {
+	owned := map[uint32]bool{}
+	for _, id := range col.Cards {
+		owned[id] = true
+	}
+	return owned
+}
+
+// checkOwned refuses the first card in ids that is not owned.
+// Cards the catalog does not know are left for CheckDeck to refuse.
+func checkOwned(ids []uint32, owned map[uint32]bool) error {
+	reg := CurrentCatalog().Registry()
+	for _, id := range ids {
+		card, ok := reg.Get(id)
+		if ok && !owned[id] {
+			return badDeck("card %d (%s) is not in your collection", id, card.name)
+		}
+	}
+	return nil
+}
+
+func isDeckRequest(msg protocol.Message) bool {
+	switch msg.(type) {
+	case protocol.DeckSet, protocol.DeckSave, protocol.DecksRequest, protocol.DeckSelect, protocol.CollectionRequest:
+		return true
+	}
+	return false
+}
+
+// handleDeck serves deck and collection requests without holding g.mutex while
+// the store is queried, so a slow database does not stall the match.
+func (p *Player) handleDeck(msg protocol.Message) {
+	g := p.game
+	g.mutex.Lock()
+	account, decks, cards, spectator := p.account, g.Decks, g.Collection, p.spectator
+	g.mutex.Unlock()
+
+	ctx := context.Background()
+	// 덱에 넣는 카드는 가지고 있어야 한다
+	owns := func(ids []uint32) (map[uint32]bool, error) {
+		if cards == nil {
+			return nil, nil
+		}
+		col, err := collection(ctx, cards, account)
+		if err != nil {
+			return nil, err
+		}
+		owned := ownedSet(col)
+		return owned, checkOwned(ids, owned)
+	}
+	// 대기실에서만 덱을 바꾼다
+	use := func(ids []uint32) error {
+		if spectator {
+			return ErrSpectatorDeck
+		}
+		owned, err := owns(ids)
+		if err != nil {
+			return err
+		}
+		g.mutex.Lock()
+		defer g.mutex.Unlock()
+		if g.status == StatusPlaying {
+			return ErrInGame
+		}
+		if err := p.SetDeck(ids); err != nil {
+			return err
+		}
+		p.owned = owned
+		// 매칭된 자리는 덱이 갖춰지면 바로 준비
+		if p.matched {
+			p.ready = true
+		}
+		g.RoomChanged()
+		return nil
+	}
+
+	var out []byte
+	err := func() error {
+		switch m := msg.(type) {
+		case protocol.DeckSet:
+			return use(m.Cards)
+		case protocol.CollectionRequest:
+			if cards == nil {
+				return ErrNoCollection
+			}
+			col, err := collection(ctx, cards, account)
+			if err != nil {
+				return err
+			}
+			out = col.Encode()
+			return nil
+		}
+
+		if decks == nil {
+			return ErrNoDeckStore
+		}
+		if account == "" {
+			return ErrNoName
+		}
+		switch m := msg.(type) {
+		case protocol.DeckSave:
+			// 팀별 카드는 고를 때 본다
+			if err := CurrentCatalog().CheckDeck(m.Cards[:], protocol.NoTeam); err != nil {
+				return err
+			}
+			if _, err := owns(m.Cards[:]); err != nil {
+				return err
+			}
+			if _, err := decks.SaveDeck(ctx, account, m.SavedDeck); err != nil {
+				return err
+			}
+		case protocol.DeckSelect:
+			d, err := decks.Deck(ctx, account, m.ID)
+			if err != nil {
+				return err
+			}
+			return use(d.Cards[:])
+		}
+		// 저장했거나 목록을 물었으면 목록으로 답한다
+		list, err := decks.Decks(ctx, account)
+		if err != nil {
+			return err
+		}
+		out = protocol.DeckList{Decks: list}.Encode()
+		return nil
+	}()
+	if _, ok := err.(protocol.Error); err != nil && !ok {
+		log.Println("deck request :", err)
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if out != nil {
+		p.write(out)
+	}
+	p.reply(err)
+}
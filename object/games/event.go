@@ -0,0 +1,145 @@
+package games
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type EventKind byte
+
+const (
+	EventCardUsed EventKind = iota
+	EventUnitSpawned
+	EventUnitDied
+	EventUnitPoisoned
+	EventEnergyGained
+	EventGameEnded
+)
+
+var eventNames = [...]string{
+	EventCardUsed:     "card_used",
+	EventUnitSpawned:  "unit_spawned",
+	EventUnitDied:     "unit_died",
+	EventUnitPoisoned: "unit_poisoned",
+	EventEnergyGained: "energy_gained",
+	EventGameEnded:    "game_ended",
+}
+
+func (k EventKind) String() string {
+	if int(k) < len(eventNames) {
+		return eventNames[k]
+	}
+	return fmt.Sprintf("event(%d)", byte(k))
+}
+
+func (k EventKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (k *EventKind) UnmarshalText(b []byte) error {
+	for i, name := range eventNames {
+		if name == string(b) {
+			*k = EventKind(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown event %q", b)
+}
+
+// Event is one thing that happened in a match. Which fields mean anything depends on Kind:
+//
+//	card_used     Player, Team, Card, X, Value(cost)
+//	unit_spawned  Player, Team, Unit, Type, X
+//	unit_died     Team, Unit, Type
+//	unit_poisoned Team(before), Unit, Type
+//	energy_gained Player, Team, Value
+//	game_ended    Team(winner)
+type Event struct {
+	Frame  uint      `json:"frame"` // 게임 시작부터
+	Kind   EventKind `json:"kind"`
+	Player uint16    `json:"player,omitempty"`
+	Team   byte      `json:"team"`
+	Unit   uint16    `json:"unit,omitempty"`
+	Type   uint16    `json:"type,omitempty"`
+	Card   uint32    `json:"card,omitempty"`
+	X      float64   `json:"x,omitempty"`
+	Value  int       `json:"value,omitempty"`
+}
+
+func (e Event) String() string {
+	b, _ := json.Marshal(e)
+	return string(b)
+}
+
+func ParseEvent(s string) (Event, error) {
+	var e Event
+	err := json.Unmarshal([]byte(s), &e)
+	return e, err
+}
+
+// Timeline is the events of one match in the order they happened.
+type Timeline []Event
+
+func (t Timeline) Strings() []string {
+	list := make([]string, len(t))
+	for i, e := range t {
+		list[i] = e.String()
+	}
+	return list
+}
+
+func ParseTimeline(list []string) (Timeline, error) {
+	t := make(Timeline, 0, len(list))
+	for _, s := range list {
+		e, err := ParseEvent(s)
+		if err != nil {
+			return nil, err
+		}
+		t = append(t, e)
+	}
+	return t, nil
+}
+
+func (t Timeline) Filter(keep func(Event) bool) Timeline {
+	var out Timeline
+	for _, e := range t {
+		if keep(e) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func (t Timeline) Kind(kinds ...EventKind) Timeline {
+	return t.Filter(func(e Event) bool {
+		for _, k := range kinds {
+			if e.Kind == k {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (t Timeline) Team(team byte) Timeline {
+	return t.Filter(func(e Event) bool { return e.Team == team })
+}
+
+// Between keeps events with from <= Frame < to.
+func (t Timeline) Between(from, to uint) Timeline {
+	return t.Filter(func(e Event) bool { return e.Frame >= from && e.Frame < to })
+}
+
+// emit expects g.mutex to be held.
+func (g *Game) emit(e Event) {
+	if g.status != StatusPlaying {
+		return
+	}
+	e.Frame = g.tick
+	g.events = append(g.events, e)
+}
+
+func unitEvent(kind EventKind, u IUnit) Event {
+	s := u.State()
+	return Event{Kind: kind, Team: s.Team, Unit: s.ID, Type: s.TypeID, X: s.X}
+}
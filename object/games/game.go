@@ -1,14 +1,16 @@
 package games
 
 import (
+	"bytes"
 	"encoding/binary"
 	"log"
 	"math"
 	"math/rand"
-	"net"
 	"sync"
 	"time"
 
+	"app/protocol"
+
 	quadtree "github.com/ybs1164/quadtree-go"
 )
 
@@ -27,10 +29,28 @@ type Game struct {
 
 	players     [3]*Player
 	PlayerCount int
+	playerID    uint16
+	spectators  []*Player
 
-	status      byte // 0: ready.. 1: gaming
+	status      byte // 0: ready.. 1: gaming 2: countdown
+	countdown   uint16
+	host        *Player
 	frame       uint
+	tick        uint // 게임 시작부터 지난 프레임
+	started     time.Time
+	events      Timeline
+	departed    []Seat // 이번 판 도중에 나간 사람
 	energySpeed uint16
+	catalog     *Catalog // 이번 판의 카드/유닛 표
+
+	seed      int64
+	rand      *rand.Rand
+	source    *countingSource
+	replay    *Replay // 기록 중인 것
+	replaying *Replay // 재생 중인 것
+	next      int     // 다음에 적용할 replaying.Inputs
+	playback  *playback
+	silent    bool // 되감기 중에는 아무것도 보내지 않는다
 
 	objID       uint16
 	units       []IUnit
@@ -39,12 +59,52 @@ type Game struct {
 	ticker      *time.Ticker
 	spawner     []Spawn
 	mutex       sync.Mutex
+
+	snapshotSeq uint32
+	snapshots   [snapshotHistory]*protocol.Snapshot
+
+	done    chan struct{}
+	closed  bool
+	OnEmpty func(*Game)         // 마지막 플레이어가 나갔을 때, mutex 밖에서 호출
+	OnEnd   func(*Game, Result) // 게임이 끝났을 때, mutex 안에서 호출되니 오래 걸리는 일은 따로
+	Decks   DeckStore           // 저장한 덱, nil 이면 쓰지 않는다
+
+	Collection CollectionStore // 가진 카드, nil 이면 모든 카드를 쓸 수 있다
 }
 
-func NewGame() *Game {
-	rand.Seed(time.Now().UnixNano())
+// 한 판의 길이
+const MatchFrames = 60 * 180
 
+type Seat struct {
+	Name string // 로그인한 이름, 손님이면 ""
+	Team byte
+	Deck []uint32 // card id
+	Left bool     // 끝나기 전에 나갔다, 진 것으로 친다
+}
+
+// Result is what a finished match leaves behind.
+type Result struct {
+	Winner   byte
+	Players  []Seat
+	Duration uint // frame
+	Started  time.Time
+	Events   Timeline
+	Replay   *Replay
+	Catalog  *Catalog // 이번 판의 카드/유닛 표
+}
+
+var ErrRoomFull = protocol.Error{Code: protocol.ErrCodeRoomFull, Message: "room is full"}
+var ErrRoomClosed = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "room is closed"}
+
+func NewGame(id uint64) *Game {
+	g := newGame(id)
+	g.run()
+	return g
+}
+
+func newGame(id uint64) *Game {
 	g := new(Game)
+	g.id = id
 	g.qt = &quadtree.Quadtree{
 		Bounds: quadtree.Bounds{
 			X:      0,
@@ -60,15 +120,123 @@ func NewGame() *Game {
 	}
 	g.units = []IUnit{}
 	g.mutex = sync.Mutex{}
-	g.ticker = time.NewTicker(time.Second / 60)
-	//defer ticker.Stop()
-	go g.Frame()
+	g.done = make(chan struct{})
 	return g
 }
 
-func (g *Game) Join(c net.Conn) {
+func (g *Game) ID() uint64 {
+	return g.id
+}
+
+// PlayID is the DB id of the last match recorded from this room, 0 if none.
+func (g *Game) PlayID() uint64 {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.playID
+}
+
+func (g *Game) SetPlayID(id uint64) {
+	g.mutex.Lock()
+	g.playID = id
+	g.mutex.Unlock()
+}
+
+// Close stops the ticker and drops every remaining connection.
+func (g *Game) Close() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if g.closed {
+		return
+	}
+	g.closed = true
+	if g.ticker != nil {
+		g.ticker.Stop()
+	}
+	close(g.done)
+	for _, p := range g.Viewers() {
+		if p.graceTimer != nil {
+			p.graceTimer.Stop()
+		}
+		if p.conn != nil {
+			p.conn.Close()
+		}
+	}
+	log.Println("room closed", g.id)
+}
+
+type Info struct {
+	Players    int
+	Spectators int
+	Status     byte
+	Teams      [3]bool
+}
+
+func (g *Game) Info() Info {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	info := Info{
+		Players:    g.PlayerCount,
+		Spectators: len(g.spectators),
+		Status:     g.status,
+	}
+	for i := 0; i < g.PlayerCount; i++ {
+		if team := g.players[i].team; team < 3 {
+			info.Teams[team] = true
+		}
+	}
+	return info
+}
+
+// Join gives c a player slot. account is the name c logged in as, "" for a guest.
+// greeting frames are queued ahead of everything else sent to it.
+func (g *Game) Join(c protocol.Conn, reply protocol.HelloReply, account string, greeting ...[]byte) error {
+	return g.join(c, reply, account, protocol.NoTeam, greeting)
+}
+
+// Seat joins c as account on team, as the matchmaker arranged it.
+// It is ready as soon as it has picked a legal deck.
+func (g *Game) Seat(c protocol.Conn, reply protocol.HelloReply, account string, team byte, greeting ...[]byte) error {
+	return g.join(c, reply, account, team, greeting)
+}
+
+// canJoin expects g.mutex to be held.
+func (g *Game) canJoin(team byte) error {
+	switch {
+	case g.closed:
+		return ErrRoomClosed
+	case g.PlayerCount >= 3:
+		return ErrRoomFull
+	case g.playback != nil:
+		return ErrReadOnly
+	case g.status == StatusPlaying:
+		return ErrInGame
+	case team != protocol.NoTeam && g.TeamPlayer(team) != nil:
+		return ErrTeamTaken
+	}
+	return nil
+}
+
+func (g *Game) join(c protocol.Conn, reply protocol.HelloReply, account string, team byte, greeting [][]byte) error {
+	p := PlayerSet(g, nil)
+	p.name = account
+	p.account = account
+	p.matched = team != protocol.NoTeam
+	p.team = team
+	// sessionsMutex 는 g.mutex 보다 먼저 잡는다 (Resume, expire 와 같은 순서)
+	newSession(p)
+
 	g.mutex.Lock()
-	p := PlayerSet(g, c)
+	if err := g.canJoin(team); err != nil {
+		g.mutex.Unlock()
+		forgetSession(p)
+		return err
+	}
+	p.attach(c, reply)
+	for _, data := range greeting {
+		p.write(data)
+	}
+	g.playerID++
+	p.id = g.playerID
 	for i := 0; i < 3; i++ {
 		if g.players[i] == nil {
 			g.players[i] = p
@@ -76,69 +244,118 @@ func (g *Game) Join(c net.Conn) {
 		}
 	}
 	g.PlayerCount++
+	if g.host == nil {
+		g.host = p
+	}
+	p.sendSession(false)
+	g.RoomChanged()
 	g.mutex.Unlock()
 
 	go p.ConnHandler()
+	return nil
 }
 
 func (g *Game) Left(p *Player) {
+	forgetSession(p)
+
 	g.mutex.Lock()
-	for i := 0; i < g.PlayerCount; i++ {
-		if i > 0 && g.players[i-1] == nil {
-			g.players[i-1] = g.players[i]
-		}
-		if g.players[i] == p {
-			g.players[i] = nil
-		}
+	if !g.removePlayer(p) {
+		g.mutex.Unlock()
+		return
 	}
-	g.PlayerCount--
+	if p.graceTimer != nil {
+		p.graceTimer.Stop()
+		p.graceTimer = nil
+	}
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	empty := g.PlayerCount == 0
+	onEmpty := g.OnEmpty
 	g.mutex.Unlock()
 
 	// todo : leave event
 
 	log.Println("left " + p.name)
+
+	if empty && onEmpty != nil {
+		onEmpty(g)
+	}
 }
 
-func (g *Game) Start() {
-	/*
-		if g.PlayerCount < 3 {
-			g.Broadcast(append([]byte{2}, string("플레이어가 3명 모여야 합니다.")...))
-			return
+// removePlayer frees p's slot. It expects g.mutex to be held.
+func (g *Game) removePlayer(p *Player) bool {
+	found := false
+	for i := 0; i < g.PlayerCount; i++ {
+		if g.players[i] == p {
+			copy(g.players[i:], g.players[i+1:])
+			g.players[len(g.players)-1] = nil
+			found = true
+			break
 		}
+	}
+	if !found {
+		return false
+	}
+	g.recordInput(Input{Kind: InputLeave, Team: p.team})
+	if g.status == StatusPlaying {
+		g.departed = append(g.departed, Seat{Name: p.account, Team: p.team, Deck: p.DeckIDs(), Left: true})
+	}
+	g.PlayerCount--
+	if g.host == p {
+		g.host = g.players[0]
+	}
+	g.RoomChanged()
+	return true
+}
 
-		teamOn := [3]bool{false, false, false}
-
-		for _, p := range g.players {
-			if teamOn[p.team] {
-				g.Broadcast(append([]byte{2}, string("팀은 각각 하나씩 가져야 합니다.")...))
-				return
-			} else {
-				teamOn[p.team] = true
-			}
+// TeamPlayer returns the player currently playing team, or nil.
+func (g *Game) TeamPlayer(team byte) *Player {
+	for i := 0; i < g.PlayerCount; i++ {
+		if g.players[i].team == team {
+			return g.players[i]
 		}
+	}
+	return nil
+}
 
-		sort.Slice(g.players[:], func(i, j int) bool {
-			return g.players[i].team < g.players[j].team
-		})
-	*/
+func (g *Game) Start() {
+	g.sortByTeam()
+
+	// 시뮬레이션의 무작위는 모두 g.rand 로, 리플레이는 seed 를 그대로 쓴다
+	if g.replaying == nil {
+		g.seed = time.Now().UnixNano()
+	}
+	g.rand, g.source = newRandAt(g.seed, 0)
+	g.catalog = CurrentCatalog()
 
 	for i := 0; i < g.PlayerCount; i++ {
 		p := g.players[i]
+		p.setDeck(g.catalog, p.DeckIDs()) // 지난 판에 바뀐 코스트를 되돌린다
 		p.order = [8]byte{0, 1, 2, 3, 4, 5, 6, 7}
-		//rand.Shuffle(len(p.order), func(i, j int) { p.order[i], p.order[j] = p.order[j], p.order[i] })
+		//g.rand.Shuffle(len(p.order), func(i, j int) { p.order[i], p.order[j] = p.order[j], p.order[i] })
 		p.energy = 5
 		p.energyTime = 120
 		p.maxEnergy = 10
 	}
-	earth := NewEarth()
+	earth := g.catalog.NewEarth()
 	earth.Run(g.players[0], 0)
 	g.units = []IUnit{earth}
+	g.projectiles = []IProjectile{}
+	g.spawner = nil
 
 	g.energySpeed = 1
-	g.status = 1
-	g.frame = 60 * 180
+	g.status = StatusPlaying
+	g.countdown = 0
+	g.frame = MatchFrames
+	g.tick = 0
+	g.started = time.Now()
+	g.events = nil
+	g.departed = nil
+	g.recordStart()
 
 	g.objID = 1
+	g.ResetSnapshots()
 
 	g.Broadcast([]byte{1})
 
@@ -146,6 +363,11 @@ func (g *Game) Start() {
 }
 
 func (g *Game) End(team byte) {
+	// 한 경기는 한 번만 끝난다
+	if g.status != StatusPlaying {
+		return
+	}
+	g.recordEnd(team)
 	g.units = []IUnit{}
 	g.projectiles = []IProjectile{}
 
@@ -156,18 +378,61 @@ func (g *Game) End(team byte) {
 
 	g.Broadcast(data)
 
-	g.status = 0
+	g.emit(Event{Kind: EventGameEnded, Team: team})
+	if g.OnEnd != nil {
+		res := Result{
+			Winner:   team,
+			Duration: MatchFrames - g.frame,
+			Started:  g.started,
+			Events:   g.events,
+			Replay:   g.replay,
+			Catalog:  g.catalog,
+		}
+		for i := 0; i < g.PlayerCount; i++ {
+			p := g.players[i]
+			res.Players = append(res.Players, Seat{Name: p.account, Team: p.team, Deck: p.DeckIDs()})
+		}
+		res.Players = append(res.Players, g.departed...)
+		g.OnEnd(g, res)
+	}
+
+	g.status = StatusReady
+	for i := 0; i < g.PlayerCount; i++ {
+		g.players[i].ready = false
+	}
+	g.RoomChanged()
 
 	log.Println("Game End")
 }
 
 func (g *Game) Broadcast(data []byte) {
-	for i := 0; i < g.PlayerCount; i++ {
-		p := g.players[i]
+	for _, p := range g.Viewers() {
 		p.Send(data)
 	}
 }
 
+// Viewers returns the players followed by the spectators.
+func (g *Game) Viewers() []*Player {
+	list := make([]*Player, 0, g.PlayerCount+len(g.spectators))
+	list = append(list, g.players[:g.PlayerCount]...)
+	return append(list, g.spectators...)
+}
+
+func (g *Game) WorldData(enc protocol.Encoding) []byte {
+	var data []byte
+
+	data = append(data, 4)
+
+	for _, unit := range g.units {
+		data = append(data, unit.Data(enc)...)
+	}
+	for _, proj := range g.projectiles {
+		data = append(data, proj.Data(enc)...)
+	}
+
+	return data
+}
+
 func (g *Game) Spawn(u Spawn) {
 	g.spawner = append(g.spawner, u)
 }
@@ -198,192 +463,252 @@ func (g *Game) Collision(x, y, w, h float64) []IUnit {
 }
 
 func (g *Game) Frame() {
-	second := 60
-	for range g.ticker.C {
+	var frames uint
+	var sent uint
+	second := 0
+	step := time.Second / time.Duration(Tick.SimRate)
+	next := time.Now()
+	for {
+		select {
+		case <-g.done:
+			return
+		case <-g.ticker.C:
+		}
 		g.mutex.Lock()
 
-		// ping
-		if second < 1 {
-			for i := 0; i < g.PlayerCount; i++ {
-				g.players[i].lastTime = append(g.players[i].lastTime, time.Now().UnixNano())
+		// 늦게 깨어났으면 밀린 만큼 따라잡는다
+		now := time.Now()
+		run := 0
+		for !now.Before(next) && run < Tick.MaxCatchUp {
+			// ping
+			if second < 1 {
+				g.heartbeat()
+				second = int(Heartbeat.Interval * time.Duration(Tick.SimRate) / time.Second)
 			}
-			g.Broadcast([]byte{5})
-			second = 60
+			second--
+
+			g.simulate()
+			frames++
+			run++
+			next = next.Add(step)
+		}
+		if run > 1 {
+			simOverruns.Add(int64(run - 1))
+		}
+		if behind := now.Sub(next); behind >= 0 { // 너무 밀렸으면 버린다
+			dropped := int64(behind/step) + 1
+			simDropped.Add(dropped)
+			log.Println("room", g.id, "sim behind, dropped", dropped, "frames")
+			next = now.Add(step)
+		}
+
+		if frames/Tick.sendEvery() != sent {
+			sent = frames / Tick.sendEvery()
+			g.sendState()
+		}
+		g.mutex.Unlock()
+	}
+}
+
+// simulate runs one fixed step of whatever the room is doing.
+func (g *Game) simulate() {
+	switch g.status {
+	case StatusReady: // room
+	case StatusCountdown:
+		g.tickCountdown()
+	case StatusPlaying: // game
+		if g.playback != nil {
+			g.playbackFrame()
+		} else {
+			g.Step()
+		}
+	}
+}
+
+// sendState sends the player list and, during a match, player states and the world.
+// It runs at Tick.SendRate, independently of the simulation.
+func (g *Game) sendState() {
+	var data []byte
+
+	data = append(data, 0)
+
+	for i := 0; i < g.PlayerCount; i++ {
+		data = append(data, g.players[i].Data()...)
+	}
+
+	// 델타 클라이언트에게는 바뀌었을 때만
+	for _, p := range g.Viewers() {
+		if p.caps.Has(protocol.CapDeltaSnapshot) && bytes.Equal(p.lastPlayers, data) {
+			continue
 		}
-		second--
+		p.lastPlayers = data
+		p.Send(data)
+	}
 
+	if g.status != StatusPlaying {
+		return
+	}
+
+	for i := 0; i < g.PlayerCount; i++ {
 		var data []byte
+		p := g.players[i]
 
-		data = append(data, 0)
+		data = append(data, 3)
 
-		for i := 0; i < g.PlayerCount; i++ {
-			data = append(data, g.players[i].Data()...)
+		var value []byte = make([]byte, 2)
+		binary.BigEndian.PutUint16(value, p.id)
+		data = append(data, value...)
+		binary.BigEndian.PutUint16(value, p.energy)
+		data = append(data, value...)
+		binary.BigEndian.PutUint16(value, p.maxEnergy)
+		data = append(data, value...)
+
+		// 경기 시간
+		var time uint16 = uint16(g.frame / 60)
+		binary.BigEndian.PutUint16(value, time)
+		data = append(data, value...)
+		//
+
+		for _, value := range p.order {
+			data = append(data, value)
+		}
+		for _, c := range p.deck {
+			data = append(data, c.Data()...)
 		}
 
-		g.Broadcast(data)
-
-		// game
-		switch g.status {
-		case 0: // room
-		case 1: // game
-			// spawn
-			for i := 0; i < len(g.spawner); i++ {
-				s := &g.spawner[i]
-				if s.time > 0 {
-					s.time--
-				} else {
-					owner := s.owner
-					x, y := s.x, s.y
-					u := s.obj
-					switch u.(type) {
-					case IUnit:
-						// todo : unit waiting time
-						unit := u.(IUnit)
-						g.units = append(g.units, unit)
-						unit.HitBox().X = x - unit.HitBox().Width/2
-						unit.HitBox().Y = y
-						unit.Run(owner, g.objID)
-					case IProjectile:
-						proj := u.(IProjectile)
-						g.projectiles = append(g.projectiles, proj)
-						proj.HitBox().X = x - proj.HitBox().Width/2
-						proj.HitBox().Y = y
-						proj.Run(owner, g.objID)
-					case IMagic:
-						// todo
-					default:
-						log.Println("what?")
-					}
-					g.objID++
-					g.spawner = append(g.spawner[:i], g.spawner[i+1:]...)
-					i--
-
-					var data []byte = make([]byte, 17)
-
-					data[0] = 8
-
-					binary.BigEndian.PutUint64(data[1:9], math.Float64bits(x))
-					binary.BigEndian.PutUint64(data[9:17], math.Float64bits(y))
-
-					owner.Send(data)
-				}
-			}
+		p.Send(data)
+		for _, s := range g.spectators {
+			s.Send(data)
+		}
+	}
 
-			// todo : quadtree
+	g.SendWorld()
+}
 
-			g.qt.Clear()
-			for _, unit := range g.units {
-				var b quadtree.Bounds = *unit.HitBox()
-				b.X -= b.Width / 2
-				g.qt.Insert(unit)
+// Step advances a running match by one frame. It expects g.mutex to be held.
+func (g *Game) Step() {
+	// spawn
+	for i := 0; i < len(g.spawner); i++ {
+		s := &g.spawner[i]
+		if s.time > 0 {
+			s.time--
+		} else {
+			owner := s.owner
+			x, y := s.x, s.y
+			u := s.obj
+			switch u.(type) {
+			case IUnit:
+				// todo : unit waiting time
+				unit := u.(IUnit)
+				g.units = append(g.units, unit)
+				unit.HitBox().X = x - unit.HitBox().Width/2
+				unit.HitBox().Y = y
+				unit.Run(owner, g.objID)
+				e := unitEvent(EventUnitSpawned, unit)
+				e.Player = owner.id
+				g.emit(e)
+			case IProjectile:
+				proj := u.(IProjectile)
+				g.projectiles = append(g.projectiles, proj)
+				proj.HitBox().X = x - proj.HitBox().Width/2
+				proj.HitBox().Y = y
+				proj.Run(owner, g.objID)
+			case IMagic:
+				// todo
+			default:
+				log.Println("what?")
 			}
+			g.objID++
+			g.spawner = append(g.spawner[:i], g.spawner[i+1:]...)
+			i--
 
-			for _, unit := range g.units {
-				unit.Frame()
-			}
+			var data []byte = make([]byte, 17)
 
-			for _, p := range g.projectiles {
-				p.Frame()
-			}
+			data[0] = 8
 
-			var isAllP = true
-
-			for i := 0; i < len(g.units); i++ {
-				unit := &g.units[i]
-				if (*unit).Team() != 2 {
-					isAllP = false
-				}
-				if (*unit).IsDead() {
-					(*unit).Death()
-					g.units = append(g.units[:i], g.units[i+1:]...)
-					i--
-				} else if (*unit).Team() != 2 && (*unit).IsPoisoned() {
-					(*unit).Poisoned()
-				}
-			}
+			binary.BigEndian.PutUint64(data[1:9], math.Float64bits(x))
+			binary.BigEndian.PutUint64(data[9:17], math.Float64bits(y))
 
-			for i := 0; i < len(g.projectiles); i++ {
-				pr := &g.projectiles[i]
-				if (*pr).IsUsing() {
-					(*pr).Death()
-					g.projectiles = append(g.projectiles[:i], g.projectiles[i+1:]...)
-					i--
-				}
+			// 관전자도 본다, 리플레이에서는 주인에게 연결이 없다
+			owner.Send(data)
+			for _, v := range g.spectators {
+				v.Send(data)
 			}
+		}
+	}
 
-			// add energy to players
-			for i := 0; i < g.PlayerCount; i++ {
-				p := g.players[i]
-				if p.energy < p.maxEnergy {
-					if p.energyTime-g.energySpeed < 0 {
-						p.energyTime = 0
-					} else {
-						p.energyTime = p.energyTime - g.energySpeed
-					}
-
-					if p.energyTime == 0 {
-						p.GetEnergy(1)
-						p.energyTime = 120
-					}
-				}
-			}
+	// todo : quadtree
 
-			// send data
-			for i := 0; i < g.PlayerCount; i++ {
-				var data []byte
-				p := g.players[i]
-
-				data = append(data, 3)
-
-				var value []byte = make([]byte, 2)
-				binary.BigEndian.PutUint16(value, p.id)
-				data = append(data, value...)
-				binary.BigEndian.PutUint16(value, p.energy)
-				data = append(data, value...)
-				binary.BigEndian.PutUint16(value, p.maxEnergy)
-				data = append(data, value...)
-
-				// 경기 시간
-				var time uint16 = uint16(g.frame / 60)
-				binary.BigEndian.PutUint16(value, time)
-				data = append(data, value...)
-				//
-
-				for _, value := range p.order {
-					data = append(data, value)
-				}
-				for _, c := range p.deck {
-					data = append(data, c.Data()...)
-				}
-
-				p.Send(data)
-			}
+	g.qt.Clear()
+	for _, unit := range g.units {
+		var b quadtree.Bounds = *unit.HitBox()
+		b.X -= b.Width / 2
+		g.qt.Insert(unit)
+	}
 
-			var data []byte
+	for _, unit := range g.units {
+		unit.Frame()
+	}
 
-			data = append(data, 4)
+	for _, p := range g.projectiles {
+		p.Frame()
+	}
 
-			for _, unit := range g.units {
-				data = append(data, unit.Data()...)
-			}
-			for _, proj := range g.projectiles {
-				data = append(data, proj.Data()...)
-			}
+	var isAllP = true
 
-			g.Broadcast(data)
+	for i := 0; i < len(g.units); i++ {
+		unit := &g.units[i]
+		if (*unit).Team() != 2 {
+			isAllP = false
+		}
+		if (*unit).IsDead() {
+			g.emit(unitEvent(EventUnitDied, *unit))
+			(*unit).Death()
+			g.units = append(g.units[:i], g.units[i+1:]...)
+			i--
+		} else if (*unit).Team() != 2 && (*unit).IsPoisoned() {
+			g.emit(unitEvent(EventUnitPoisoned, *unit))
+			(*unit).Poisoned()
+		}
+	}
 
-			g.frame--
+	for i := 0; i < len(g.projectiles); i++ {
+		pr := &g.projectiles[i]
+		if (*pr).IsUsing() {
+			(*pr).Death()
+			g.projectiles = append(g.projectiles[:i], g.projectiles[i+1:]...)
+			i--
+		}
+	}
 
-			// semo win
-			if isAllP {
-				g.End(2)
+	// add energy to players
+	for i := 0; i < g.PlayerCount; i++ {
+		p := g.players[i]
+		if p.energy < p.maxEnergy {
+			if p.energyTime-g.energySpeed < 0 {
+				p.energyTime = 0
+			} else {
+				p.energyTime = p.energyTime - g.energySpeed
 			}
 
-			// dongrami win
-			if g.frame == 0 {
-				g.End(0)
+			if p.energyTime == 0 {
+				p.GetEnergy(1)
+				p.energyTime = 120
 			}
 		}
-		g.mutex.Unlock()
+	}
+
+	g.frame--
+	g.tick++
+
+	// semo win
+	if isAllP {
+		g.End(2)
+		return
+	}
+
+	// dongrami win
+	if g.frame == 0 {
+		g.End(0)
 	}
 }
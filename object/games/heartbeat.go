@@ -0,0 +1,94 @@
+package games
+
+import (
+	"log"
+	"time"
+)
+
+type HeartbeatConfig struct {
+	Interval     time.Duration // ping 간격
+	Timeout      time.Duration // 가장 오래된 ping 에 이 시간 동안 답이 없으면 끊는다
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+var Heartbeat = HeartbeatConfig{
+	Interval:     time.Second,
+	Timeout:      10 * time.Second,
+	ReadTimeout:  15 * time.Second,
+	WriteTimeout: 5 * time.Second,
+}
+
+// rttEstimator smooths round trip samples the same way TCP does (RFC 6298).
+type rttEstimator struct {
+	srtt   time.Duration
+	rttvar time.Duration
+	ok     bool
+}
+
+func (r *rttEstimator) Sample(rtt time.Duration) {
+	if !r.ok {
+		r.srtt = rtt
+		r.rttvar = rtt / 2
+		r.ok = true
+		return
+	}
+	diff := r.srtt - rtt
+	if diff < 0 {
+		diff = -diff
+	}
+	r.rttvar = (3*r.rttvar + diff) / 4
+	r.srtt = (7*r.srtt + rtt) / 8
+}
+
+func (r *rttEstimator) RTT() time.Duration {
+	return r.srtt
+}
+
+func (r *rttEstimator) Jitter() time.Duration {
+	return r.rttvar
+}
+
+func (p *Player) Ping(now time.Time) {
+	p.lastTime = append(p.lastTime, now.UnixNano())
+	p.Send([]byte{5})
+}
+
+func (p *Player) Pong(now time.Time) {
+	if len(p.lastTime) == 0 {
+		return
+	}
+	p.rtt.Sample(time.Duration(now.UnixNano() - p.lastTime[0]))
+	p.lastTime = p.lastTime[1:]
+}
+
+// IsUnresponsive reports whether the oldest outstanding ping is older than Heartbeat.Timeout.
+func (p *Player) IsUnresponsive(now time.Time) bool {
+	if len(p.lastTime) == 0 {
+		return false
+	}
+	return now.Sub(time.Unix(0, p.lastTime[0])) > Heartbeat.Timeout
+}
+
+// Evict drops the connection; the player keeps the slot for ReconnectGrace like any other disconnect.
+func (p *Player) Evict() {
+	if p.conn == nil {
+		return
+	}
+	log.Println("evict unresponsive", p.name, p.conn.RemoteAddr())
+	p.conn.Close()
+}
+
+func (g *Game) heartbeat() {
+	now := time.Now()
+	for _, p := range g.Viewers() {
+		if p.conn == nil {
+			continue
+		}
+		if p.IsUnresponsive(now) {
+			p.Evict()
+			continue
+		}
+		p.Ping(now)
+	}
+}
@@ -0,0 +1,229 @@
+package games
+
+import (
+	"math/rand"
+
+	quadtree "github.com/ybs1164/quadtree-go"
+)
+
+// 리플레이를 돌리면서 이만큼의 프레임마다 시뮬레이션 상태를 남긴다
+var KeyframeEvery uint = 60 * 10
+
+// keyframe is a deep copy of a running match, enough to carry on from it
+// exactly as if it had been simulated from frame 0.
+type keyframe struct {
+	tick        uint
+	frame       uint
+	next        int
+	objID       uint16
+	energySpeed uint16
+	drawn       uint64
+
+	players [3]*Player
+	count   int
+	host    *Player
+	seats   [3]seatState
+
+	units       []IUnit
+	projectiles []IProjectile
+	spawner     []Spawn
+	qt          quadtree.Quadtree
+
+	// 뒤에 붙이기만 하니 길이를 잘라 두면 된다
+	events   Timeline
+	departed []Seat
+	replay   Replay
+}
+
+// seatState is the part of a player the simulation changes.
+type seatState struct {
+	energy     uint16
+	energyTime uint16
+	maxEnergy  uint16
+	deck       [8]Card
+	order      [8]uint8
+}
+
+// cloner copies units and projectiles once each, however many places refer to them.
+type cloner struct {
+	units       map[IUnit]IUnit
+	projectiles map[IProjectile]IProjectile
+	links       []link
+}
+
+// link is a target pointer to point at the copy of what it pointed at.
+type link struct {
+	to   **IUnit
+	from *IUnit
+}
+
+func newCloner() *cloner {
+	return &cloner{
+		units:       map[IUnit]IUnit{},
+		projectiles: map[IProjectile]IProjectile{},
+	}
+}
+
+func (c *cloner) unit(u IUnit) IUnit {
+	if n, ok := c.units[u]; ok {
+		return n
+	}
+	n := u.clone(c)
+	c.units[u] = n
+	return n
+}
+
+func (c *cloner) projectile(p IProjectile) IProjectile {
+	if n, ok := c.projectiles[p]; ok {
+		return n
+	}
+	n := p.clone(c)
+	c.projectiles[p] = n
+	return n
+}
+
+// link has finish point to at the copy of the unit from points at.
+func (c *cloner) link(to **IUnit, from *IUnit) {
+	*to = nil
+	if from != nil {
+		c.links = append(c.links, link{to, from})
+	}
+}
+
+// finish resolves the links, copying units only a target still refers to.
+func (c *cloner) finish() {
+	for len(c.links) > 0 {
+		l := c.links[0]
+		c.links = c.links[1:]
+		u := c.unit(*l.from)
+		*l.to = &u
+	}
+}
+
+func (c *cloner) unitList(list []IUnit) []IUnit {
+	out := make([]IUnit, len(list))
+	for i, u := range list {
+		out[i] = c.unit(u)
+	}
+	return out
+}
+
+func (c *cloner) projectileList(list []IProjectile) []IProjectile {
+	out := make([]IProjectile, len(list))
+	for i, p := range list {
+		out[i] = c.projectile(p)
+	}
+	return out
+}
+
+// 아직 나오지 않은 것도 Run 전에 바뀔 수 있으니 복사한다. 마법은 바뀌지 않는다
+func (c *cloner) spawner(list []Spawn) []Spawn {
+	out := make([]Spawn, len(list))
+	for i, s := range list {
+		switch obj := s.obj.(type) {
+		case IUnit:
+			s.obj = c.unit(obj)
+		case IProjectile:
+			s.obj = c.projectile(obj)
+		}
+		out[i] = s
+	}
+	return out
+}
+
+// quadtree copies the tree as it was built, since Collision answers depend on its shape.
+func (c *cloner) quadtree(qt *quadtree.Quadtree) quadtree.Quadtree {
+	n := *qt
+	n.Objects = make([]quadtree.IBounds, len(qt.Objects))
+	for i, o := range qt.Objects {
+		n.Objects[i] = c.unit(o.(IUnit))
+	}
+	n.Nodes = make([]quadtree.Quadtree, len(qt.Nodes))
+	for i := range qt.Nodes {
+		n.Nodes[i] = c.quadtree(&qt.Nodes[i])
+	}
+	return n
+}
+
+// keyframe copies the running match. It expects g.mutex to be held.
+func (g *Game) keyframe() *keyframe {
+	c := newCloner()
+	k := &keyframe{
+		tick:        g.tick,
+		frame:       g.frame,
+		next:        g.next,
+		objID:       g.objID,
+		energySpeed: g.energySpeed,
+		drawn:       g.source.drawn,
+		players:     g.players,
+		count:       g.PlayerCount,
+		host:        g.host,
+		units:       c.unitList(g.units),
+		projectiles: c.projectileList(g.projectiles),
+		spawner:     c.spawner(g.spawner),
+		qt:          c.quadtree(g.qt),
+		events:      g.events[:len(g.events):len(g.events)],
+		departed:    g.departed[:len(g.departed):len(g.departed)],
+		replay:      *g.replay,
+	}
+	c.finish()
+	k.replay.Inputs = k.replay.Inputs[:len(k.replay.Inputs):len(k.replay.Inputs)]
+	for i := 0; i < g.PlayerCount; i++ {
+		p := g.players[i]
+		k.seats[i] = seatState{p.energy, p.energyTime, p.maxEnergy, p.deck, p.order}
+	}
+	return k
+}
+
+// restore puts g back to k, copying again so k can be used another time.
+func (g *Game) restore(k *keyframe) {
+	c := newCloner()
+	g.units = c.unitList(k.units)
+	g.projectiles = c.projectileList(k.projectiles)
+	g.spawner = c.spawner(k.spawner)
+	qt := c.quadtree(&k.qt)
+	g.qt = &qt
+	c.finish()
+
+	g.players, g.PlayerCount, g.host = k.players, k.count, k.host
+	for i := 0; i < k.count; i++ {
+		p, s := g.players[i], k.seats[i]
+		p.energy, p.energyTime, p.maxEnergy, p.deck, p.order = s.energy, s.energyTime, s.maxEnergy, s.deck, s.order
+	}
+	g.tick, g.frame, g.next = k.tick, k.frame, k.next
+	g.objID, g.energySpeed = k.objID, k.energySpeed
+	g.rand, g.source = newRandAt(g.seed, k.drawn)
+	g.events, g.departed = k.events, k.departed
+	rep := k.replay
+	g.replay = &rep
+	g.status = StatusPlaying
+}
+
+// countingSource counts what it hands out so a keyframe can rebuild the generator.
+type countingSource struct {
+	src   rand.Source64
+	drawn uint64
+}
+
+func (s *countingSource) Int63() int64 {
+	s.drawn++
+	return s.src.Int63()
+}
+
+func (s *countingSource) Uint64() uint64 {
+	s.drawn++
+	return s.src.Uint64()
+}
+
+func (s *countingSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.drawn = 0
+}
+
+// newRandAt is the generator for seed after drawn numbers were taken from it.
+func newRandAt(seed int64, drawn uint64) (*rand.Rand, *countingSource) {
+	s := &countingSource{src: rand.NewSource(seed).(rand.Source64)}
+	for ; s.drawn < drawn; s.Int63() {
+	}
+	return rand.New(s), s
+}
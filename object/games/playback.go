@@ -0,0 +1,150 @@
+package games
+
+import (
+	"log"
+
+	"app/protocol"
+)
+
+var (
+	ErrReadOnly  = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "replay rooms are watch only"}
+	ErrNotReplay = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "not a replay room"}
+)
+
+type playback struct {
+	paused bool
+	speed  byte // protocol.MinReplaySpeed ~ MaxReplaySpeed
+	acc    int
+
+	keyframes map[uint]*keyframe // tick 이 KeyframeEvery 의 배수일 때
+}
+
+// NewPlayback hosts rep as a watch-only room. Viewers join as spectators
+// and steer it with ReplayControl. rep must match the catalog in use.
+func NewPlayback(id uint64, rep *Replay) (*Game, error) {
+	if err := rep.CheckCatalog(); err != nil {
+		return nil, err
+	}
+	g := newGame(id)
+	g.playback = &playback{speed: 2, keyframes: map[uint]*keyframe{}}
+	g.load(rep)
+	g.run()
+	return g, nil
+}
+
+// load seats the recorded players and starts the match from frame 0.
+func (g *Game) load(rep *Replay) {
+	g.players = [3]*Player{}
+	g.PlayerCount = 0
+	g.replaying = rep
+	g.next = 0
+	g.seed = rep.Seed
+	for _, rp := range rep.Players {
+		p := PlayerSet(g, nil)
+		p.id = rp.ID
+		p.team = rp.Team
+		p.name = rp.Name
+		p.setDeck(CurrentCatalog(), rp.Deck[:])
+		g.players[g.PlayerCount] = p
+		g.PlayerCount++
+		if rp.ID > g.playerID {
+			g.playerID = rp.ID
+		}
+	}
+	g.Start()
+}
+
+// playbackFrame runs once per server tick, 0.5 to 8 match frames depending on speed.
+func (g *Game) playbackFrame() {
+	pb := g.playback
+	if pb.paused {
+		return
+	}
+	pb.acc += int(pb.speed)
+	for pb.acc >= 2 {
+		pb.acc -= 2
+		if !g.Advance() {
+			g.sendReplayState()
+			return
+		}
+		g.keep()
+	}
+}
+
+// keep stores a keyframe when the match reaches one it does not have yet.
+func (g *Game) keep() {
+	pb := g.playback
+	if g.status != StatusPlaying || g.tick%KeyframeEvery != 0 || pb.keyframes[g.tick] != nil {
+		return
+	}
+	pb.keyframes[g.tick] = g.keyframe()
+}
+
+// nearest is the last keyframe at or before frame, or nil.
+func (pb *playback) nearest(frame uint) *keyframe {
+	for t := frame - frame%KeyframeEvery; t > 0; t -= KeyframeEvery {
+		if k := pb.keyframes[t]; k != nil {
+			return k
+		}
+	}
+	return nil
+}
+
+// Control handles a viewer's ReplayControl. It expects g.mutex to be held.
+func (g *Game) Control(m protocol.ReplayControl) error {
+	pb := g.playback
+	if pb == nil {
+		return ErrNotReplay
+	}
+	switch m.Op {
+	case protocol.ReplayPause:
+		pb.paused = true
+	case protocol.ReplayResume:
+		pb.paused = false
+	case protocol.ReplaySpeed:
+		pb.speed = byte(m.Value)
+		pb.acc = 0
+	case protocol.ReplaySeek:
+		g.seek(uint(m.Value))
+	}
+	g.sendReplayState()
+	return nil
+}
+
+// seek re-simulates up to frame without sending anything, then gives every viewer a keyframe.
+// 뒤로 가거나 멀리 앞으로 갈 때는 가장 가까운 키프레임에서, 없으면 처음부터 다시 돌린다
+func (g *Game) seek(frame uint) {
+	rep := g.replaying
+	if rep.Duration > 0 && frame >= uint(rep.Duration) {
+		frame = uint(rep.Duration) - 1
+	}
+	behind := g.status != StatusPlaying || frame < g.tick
+	if k := g.playback.nearest(frame); k != nil && (behind || k.tick > g.tick) {
+		g.restore(k)
+	} else if behind {
+		g.load(rep)
+	}
+	g.silent = true
+	for g.tick < frame && g.Advance() {
+		g.keep()
+	}
+	g.silent = false
+	g.ResetSnapshots()
+	log.Println("replay seek", g.id, g.tick)
+}
+
+func (g *Game) sendReplayState() {
+	if g.playback == nil {
+		return
+	}
+	g.Broadcast(g.replayState().Encode())
+}
+
+func (g *Game) replayState() protocol.ReplayState {
+	return protocol.ReplayState{
+		Paused:   g.playback.paused,
+		Speed:    g.playback.speed,
+		Frame:    uint32(g.tick),
+		Duration: g.replaying.Duration,
+	}
+}
@@ -4,46 +4,86 @@ import (
 	"encoding/binary"
 	"io"
 	"log"
-	"net"
 	"strconv"
 	"time"
+
+	"app/protocol"
 )
 
 type Player struct {
-	game *Game
-	conn net.Conn
-	id   uint16
-	name string // todo : 데이터 크기 한정
+	game  *Game
+	conn  protocol.Conn
+	queue *sendQueue
+	id    uint16
+	name  string // todo : 데이터 크기 한정
+
+	account string // 로그인한 이름, 손님이면 "". 레이팅과 덱, 카드는 이 이름으로
+
+	session    protocol.SessionToken
+	graceTimer *time.Timer
+
+	spectator bool
+
+	version  uint16 // 0 : 핸드셰이크 전
+	caps     protocol.Capabilities
+	encoding protocol.Encoding
 
-	ping     int64
-	lastTime []int64
+	rtt      rttEstimator
+	lastTime []int64 // 답을 기다리는 ping 보낸 시각
+
+	acked        uint32 // 마지막으로 ack 받은 스냅샷
+	lastKeyframe uint32
+	lastPlayers  []byte
 
 	team       byte
+	ready      bool
+	matched    bool // 매칭으로 들어온 자리, 덱이 갖춰지면 준비한다
 	energy     uint16
 	energyTime uint16
 	maxEnergy  uint16
 	deck       [8]Card // Card 는 꼭 8 개여야 하는가?
 
 	order [8]uint8 // deck 의 순서결정. deck 자체의 인덱스를 바꿀 시 클라이언트에서 식별할만한 데이터가 없다.
+
+	owned map[uint32]bool // 덱을 고를 때 가지고 있던 카드, nil 이면 보지 않는다
 }
 
-func PlayerSet(game *Game, con net.Conn) *Player {
+func PlayerSet(game *Game, con protocol.Conn) *Player {
 	p := Player{}
 	p.game = game
+	p.team = protocol.NoTeam
 	p.conn = con
+	if con != nil {
+		p.queue = newSendQueue(con, SendQueueConfig)
+	}
 	return &p
 }
 
+// attach binds a negotiated connection to p. The next world update is a keyframe.
+func (p *Player) attach(c protocol.Conn, reply protocol.HelloReply) {
+	p.conn = c
+	p.queue = newSendQueue(c, SendQueueConfig)
+	p.version = reply.Version
+	p.caps = reply.Capabilities
+	p.encoding = protocol.SelectEncoding(p.caps)
+	p.acked = 0
+	p.lastPlayers = nil
+	p.lastTime = nil
+}
+
 func (p *Player) ConnHandler() {
-	if p.conn == nil {
+	conn, queue := p.conn, p.queue
+	if conn == nil {
 		return
 	}
-	defer p.game.Left(p)
-	defer p.conn.Close()
+	defer p.game.Disconnect(p, conn)
+	defer queue.Close()
+
+	go queue.Writer()
 
-	recvBuf := make([]byte, 4096)
 	for {
-		n, err := p.conn.Read(recvBuf)
+		conn.SetReadDeadline(time.Now().Add(Heartbeat.ReadTimeout))
+		data, err := conn.ReadFrame()
 		if err != nil {
 			if io.EOF == err {
 				log.Println("Failed to Connect", err)
@@ -52,111 +92,159 @@ func (p *Player) ConnHandler() {
 			log.Println(err)
 			return
 		}
-		if n > 0 { // 근데 클라에서도 패킷 뭉침 현상이 일어나면 서버에서도 일어나야 하는게 아닌가?
-			data := recvBuf[:n]
-			dataType := data[0]
-
-			p.game.mutex.Lock()
-			switch dataType {
-			case 0: // player join
-				if p.game.status == 1 {
-					break
-				}
-				p.name = string(data[1 : len(data)-1])
-				log.Println(string(p.name) + " Join Server")
-			case 1: // change team
-				if p.game.status == 1 {
-					break
-				}
-				if data[1] > 2 {
-					break
-				}
-				p.team = data[1]
-			case 2: // chat
-				if p.game.status == 1 {
-					break
-				}
-				p.Chatting(data[1 : len(data)-1]) // remove empty byte
-			case 3: // use card
-				if p.game.status == 0 {
-					break
-				}
-				order := p.order[data[1]]
-				x := int16(binary.BigEndian.Uint16(data[2:4]))
-				if x > 100 && x < -100 {
+		msg, err := protocol.Decode(data)
+		if err != nil {
+			log.Println(p.name, err)
+			continue
+		}
+		if isDeckRequest(msg) {
+			p.handleDeck(msg)
+			continue
+		}
+		p.game.mutex.Lock()
+		p.Handle(msg)
+		p.game.mutex.Unlock()
+	}
+}
 
-				}
+var ErrRename = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "logged in players keep their name"}
 
-				waitframe := 80 - uint8(p.ping/int64(time.Millisecond)*60/1000)
-
-				//log.Println(waitframe)
-
-				//
-				if p.deck[order].UseCard(p, p.energy, x, waitframe) { // using card -> change order
-					p.energy -= p.deck[order].cost
-					if p.deck[p.order[4]].id != 0 {
-						p.order[data[1]] = p.order[4]
-						for true {
-							for i := 5; i < 8; i++ {
-								p.order[i-1] = p.order[i]
-							}
-							p.order[7] = order
-							order = p.order[4]
-							if p.deck[order].id != 0 {
-								break
-							}
-						}
-					}
-				}
+func (p *Player) Handle(msg protocol.Message) {
+	if p.spectator {
+		switch msg.(type) {
+		case protocol.ChangeTeam, protocol.UseCard, protocol.DeckSet, protocol.Ready, protocol.StartGame:
+			p.write(protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "spectators cannot play"}.Encode())
+			return
+		}
+	}
 
-			case 4: // deck set
-				if p.game.status == 1 {
-					break
-				}
-				p.SetDeck(data[1:])
+	switch m := msg.(type) {
+	case protocol.JoinRequest:
+		if p.game.status == StatusPlaying {
+			break
+		}
+		if p.account != "" && m.Name != p.account {
+			p.reply(ErrRename)
+			break
+		}
+		p.name = m.Name
+		log.Println(string(p.name) + " Join Server")
+	case protocol.ChangeTeam:
+		p.reply(p.game.ChangeTeam(p, m.Team))
+	case protocol.Ready:
+		p.reply(p.game.SetReady(p, m.Ready))
+	case protocol.StartGame:
+		p.reply(p.game.RequestStart(p))
+	case protocol.Chat:
+		if p.game.status == StatusPlaying {
+			break
+		}
+		p.Chatting([]byte(m.Message))
+	case protocol.UseCard:
+		if p.game.status != StatusPlaying {
+			break
+		}
+		var waitframe uint8 = 0
+		if delay := p.rtt.RTT() * 60 / time.Second; delay < 80 {
+			waitframe = 80 - uint8(delay)
+		}
+		p.useCard(m.Slot, m.X, waitframe)
 
-			case 5:
-				p.ping = time.Now().UnixNano() - p.lastTime[0]
-				//log.Println(p.ping / int64(time.Millisecond))
-				p.lastTime = p.lastTime[1:]
-			}
-			p.game.mutex.Unlock()
+	case protocol.CardsRequest:
+		// 게임 중에는 이번 판의 표로
+		c := CurrentCatalog()
+		if p.game.status == StatusPlaying {
+			c = p.game.catalog
 		}
+		p.write(c.CardList().Encode())
+
+	case protocol.ReplayControl:
+		p.reply(p.game.Control(m))
+
+	case protocol.Ack:
+		p.Ack(m.Seq)
+
+	case protocol.Pong:
+		p.Pong(time.Now())
 	}
 }
 
 func (p *Player) Chatting(data []byte) {
-	switch string(data) {
-	case "/start":
-		p.game.Start()
-	default:
-		data = append([]byte(p.name+": "), data...)
-		data = append([]byte{2}, data...)
-		p.game.Broadcast(data)
-		/*for i := 0; i < p.game.PlayerCount; i++ {
-			other := p.game.players[i]
-			if p != other {
-				other.Send(data)
+	data = append([]byte(p.name+": "), data...)
+	data = append([]byte{2}, data...)
+	p.game.Broadcast(data)
+}
+
+// reply sends err back to this client only, if there is one.
+func (p *Player) reply(err error) {
+	if err == nil {
+		return
+	}
+	e, ok := err.(protocol.Error)
+	if !ok {
+		e = protocol.Error{Message: err.Error()}
+	}
+	p.write(e.Encode())
+}
+
+// useCard plays the card in hand slot. Live and replayed games both go through here.
+func (p *Player) useCard(slot uint8, x int16, waitframe uint8) {
+	order := p.order[slot]
+	if card := p.deck[order]; card.UseCard(p, p.energy, x, waitframe) { // using card -> change order
+		p.energy -= card.cost
+		p.game.recordInput(Input{Kind: InputCard, Team: p.team, Slot: slot, X: x, Wait: waitframe})
+		p.game.emit(Event{Kind: EventCardUsed, Player: p.id, Team: p.team, Card: card.id, X: float64(x), Value: int(card.cost)})
+		if p.deck[p.order[4]].id != 0 {
+			p.order[slot] = p.order[4]
+			for true {
+				for i := 5; i < 8; i++ {
+					p.order[i-1] = p.order[i]
+				}
+				p.order[7] = order
+				order = p.order[4]
+				if p.deck[order].id != 0 {
+					break
+				}
 			}
-		}*/
+		}
+	}
+}
+
+func (p *Player) DeckIDs() []uint32 {
+	ids := make([]uint32, len(p.deck))
+	for i, card := range p.deck {
+		ids[i] = card.id
 	}
+	return ids
 }
 
 func (p *Player) CardUsingMethod(data []byte) {
 
 }
 
-func (p *Player) SetDeck(b []byte) {
-	var cardidList [8]int
-	for i := 0; len(b) > 0; i++ {
-		cardidList[i] = int(binary.BigEndian.Uint32(b[0:4]))
-		b = b[4:]
-		if cardidList[i] == 0 {
-			i--
-		}
+// SetDeck checks the ids against the current catalog's deck rules and the
+// player's team; the deck is left as it was when it is refused.
+func (p *Player) SetDeck(cardidList []uint32) error {
+	c := CurrentCatalog()
+	if err := c.CheckDeck(cardidList, p.team); err != nil {
+		return err
 	}
+	p.setDeck(c, cardidList)
+	return nil
+}
+
+// setDeck skips ids the catalog no longer has, for decks picked under an older one.
+func (p *Player) setDeck(c *Catalog, cardidList []uint32) {
 	for i := 0; i < 8; i++ {
-		p.deck[i] = CardList[cardidList[i]]
+		p.deck[i] = Card{}
+		if i < len(cardidList) && cardidList[i] != 0 {
+			card, ok := c.Registry().Get(cardidList[i])
+			if !ok {
+				log.Println("unknown card id :", cardidList[i])
+				continue
+			}
+			p.deck[i] = card
+		}
 	}
 	var logg string = "Set Deck : "
 	for _, card := range p.deck {
@@ -187,20 +275,35 @@ func (p Player) Data() []byte {
 	return data
 }
 
-func (p Player) Send(data []byte) {
-	size := make([]byte, 2)
-	binary.BigEndian.PutUint16(size, uint16(len(data)))
-	_, err := p.conn.Write(append(size, data...))
-	if err != nil {
-		log.Println(err)
+func (p *Player) Send(data []byte) {
+	if p.version == 0 || p.game.silent {
+		return
+	}
+	p.write(data)
+}
+
+func (p *Player) write(data []byte) {
+	if p.queue == nil {
 		return
 	}
+	p.queue.Push(data)
+}
+
+func (p *Player) QueueStats() QueueStats {
+	if p.queue == nil {
+		return QueueStats{}
+	}
+	return p.queue.Stats()
 }
 
 func (p *Player) GetEnergy(e uint16) uint16 {
+	before := p.energy
 	p.energy += e
 	if p.energy > p.maxEnergy {
 		p.energy = p.maxEnergy
 	}
+	if p.energy > before {
+		p.game.emit(Event{Kind: EventEnergyGained, Player: p.id, Team: p.team, Value: int(p.energy - before)})
+	}
 	return p.energy
 }
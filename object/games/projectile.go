@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"math"
 
+	"app/protocol"
+
 	quadtree "github.com/ybs1164/quadtree-go"
 )
 
@@ -15,7 +17,10 @@ type IProjectile interface {
 	IsUsing() bool
 	Move(float64, float64)
 	Death()
-	Data() []byte
+	State() protocol.EntityState
+	Data(protocol.Encoding) []byte
+
+	clone(*cloner) IProjectile // 키프레임용
 }
 
 type Projectile struct {
@@ -63,16 +68,20 @@ func (p *Projectile) IsUsing() bool {
 	return p.using
 }
 
-func (p Projectile) Data() []byte {
-	var data []byte = make([]byte, 29)
-	binary.BigEndian.PutUint16(data[0:2], p.id)
-	binary.BigEndian.PutUint16(data[2:4], p.typeid)
-	data[4] = p.team + 3
-	// todo : pos set
-	binary.BigEndian.PutUint64(data[5:13], math.Float64bits(p.X))
-	binary.BigEndian.PutUint64(data[13:21], math.Float64bits(p.Y))
-	binary.BigEndian.PutUint64(data[21:29], math.Float64bits(p.angle))
-	return data
+func (p Projectile) State() protocol.EntityState {
+	return protocol.EntityState{
+		Kind:   protocol.KindProjectile,
+		ID:     p.id,
+		TypeID: p.typeid,
+		Team:   p.team,
+		X:      p.X,
+		Y:      p.Y,
+		Angle:  p.angle,
+	}
+}
+
+func (p Projectile) Data(enc protocol.Encoding) []byte {
+	return protocol.AppendEntity(nil, enc, p.State())
 }
 
 func (p *Projectile) Death() {
@@ -99,6 +108,12 @@ func NewThrower(height float64, t uint16, m IMagic) *Thrower {
 	return &th
 }
 
+func (t *Thrower) clone(c *cloner) IProjectile {
+	p := *t
+	c.link(&p.target, t.target)
+	return &p
+}
+
 func (t *Thrower) Run(player *Player, id uint16) {
 	t.Projectile.Run(player, id)
 	t.Y = t.height
@@ -128,6 +143,12 @@ func NewBullet(damage uint32, speed float64, life uint16, t uint16) *Bullet {
 	return &b
 }
 
+func (b *Bullet) clone(c *cloner) IProjectile {
+	p := *b
+	c.link(&p.target, b.target)
+	return &p
+}
+
 func (b *Bullet) Frame() {
 	if b.target == nil {
 		b.Move(math.Cos(b.angle)*b.speed, math.Sin(b.angle)*b.speed)
@@ -162,3 +183,9 @@ func NewCrayon(damage, speed float64) *Crayon {
 	c.typeid = 14
 	return &c
 }
+
+func (cr *Crayon) clone(c *cloner) IProjectile {
+	p := *cr
+	c.link(&p.target, cr.target)
+	return &p
+}
@@ -0,0 +1,369 @@
+package games
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+
+	"app/protocol"
+)
+
+// 파일 맨 앞 4 byte
+const replayMagic = "PRPL"
+
+// 2 : 카드/유닛 표 버전을 남긴다
+const ReplayVersion = 2
+
+// 3분에 이만큼은 말이 안 된다
+const maxReplayInputs = math.MaxUint16 * 8
+
+var (
+	ErrBadReplay       = errors.New("not a replay file")
+	ErrCatalogMismatch = errors.New("replay was recorded with another catalog")
+	ErrReplayTooLarge  = errors.New("replay does not fit the file format")
+)
+
+type InputKind byte
+
+const (
+	InputCard  InputKind = iota // 카드 사용
+	InputLeave                  // 게임 중에 나감
+)
+
+// Input is one accepted player action, applied before simulating frame Tick+1.
+type Input struct {
+	Tick uint32
+	Kind InputKind
+	Team byte
+	Slot uint8
+	X    int16
+	Wait uint8 // 받았을 때 ping 으로 정한 대기 프레임
+}
+
+type ReplayPlayer struct {
+	ID   uint16
+	Team byte
+	Name string
+	Deck [protocol.DeckSize]uint32
+}
+
+// Replay holds everything needed to run a match again through Game.Step.
+type Replay struct {
+	Seed    int64
+	Catalog string // 기록할 때의 카드/유닛 표 버전, 버전 1 파일은 ""
+	Players []ReplayPlayer
+	Inputs  []Input
+
+	Winner   byte
+	Duration uint32
+	Checksum uint64 // 끝났을 때의 월드 상태
+}
+
+// 아래 record 함수들은 g.mutex 를 잡은 상태에서 호출한다
+
+func (g *Game) recordStart() {
+	g.replay = &Replay{Seed: g.seed, Catalog: g.catalog.Version}
+	for i := 0; i < g.PlayerCount; i++ {
+		p := g.players[i]
+		rp := ReplayPlayer{ID: p.id, Team: p.team, Name: p.name}
+		for j, card := range p.deck {
+			rp.Deck[j] = card.id
+		}
+		g.replay.Players = append(g.replay.Players, rp)
+	}
+}
+
+func (g *Game) recordInput(in Input) {
+	if g.replay == nil || g.status != StatusPlaying {
+		return
+	}
+	in.Tick = uint32(g.tick)
+	g.replay.Inputs = append(g.replay.Inputs, in)
+}
+
+func (g *Game) recordEnd(winner byte) {
+	if g.replay == nil {
+		return
+	}
+	g.replay.Winner = winner
+	g.replay.Duration = uint32(g.tick)
+	g.replay.Checksum = g.Checksum()
+}
+
+// Checksum hashes the simulation state: every entity and each player's energy.
+func (g *Game) Checksum() uint64 {
+	h := fnv.New64a()
+	h.Write(g.WorldData(protocol.EncodingV1))
+	for i := 0; i < g.PlayerCount; i++ {
+		p := g.players[i]
+		var b [7]byte
+		b[0] = p.team
+		binary.BigEndian.PutUint16(b[1:], p.energy)
+		binary.BigEndian.PutUint16(b[3:], p.maxEnergy)
+		binary.BigEndian.PutUint16(b[5:], p.energyTime)
+		h.Write(b[:])
+	}
+	return h.Sum64()
+}
+
+// NewReplayGame builds a game with no connections and no ticker, started from rep.
+// Drive it with Game.Advance.
+func NewReplayGame(rep *Replay) *Game {
+	g := newGame(0)
+	g.load(rep)
+	return g
+}
+
+// Advance applies the recorded inputs due now and simulates one frame.
+// It returns false once the match is over.
+func (g *Game) Advance() bool {
+	if g.status != StatusPlaying {
+		return false
+	}
+	rep := g.replaying
+	for g.next < len(rep.Inputs) && rep.Inputs[g.next].Tick <= uint32(g.tick) {
+		g.apply(rep.Inputs[g.next])
+		g.next++
+	}
+	g.Step()
+	return g.status == StatusPlaying
+}
+
+func (g *Game) apply(in Input) {
+	p := g.TeamPlayer(in.Team)
+	if p == nil {
+		return
+	}
+	switch in.Kind {
+	case InputCard:
+		p.useCard(in.Slot, in.X, in.Wait)
+	case InputLeave:
+		g.removePlayer(p)
+	}
+}
+
+// Run plays rep to the end and returns what it finished with.
+func (rep *Replay) Run() (winner byte, duration uint32, checksum uint64) {
+	g := NewReplayGame(rep)
+	for g.Advance() {
+	}
+	return g.replay.Winner, g.replay.Duration, g.replay.Checksum
+}
+
+// CheckCatalog refuses rep unless it was recorded with the catalog now in use;
+// under any other the same inputs play out differently.
+func (rep *Replay) CheckCatalog() error {
+	if current := CurrentCatalog().Version; rep.Catalog != current {
+		return fmt.Errorf("%w: recorded with %q, running %q", ErrCatalogMismatch, rep.Catalog, current)
+	}
+	return nil
+}
+
+// Verify reports whether running rep again ends exactly as recorded.
+func (rep *Replay) Verify() error {
+	if err := rep.CheckCatalog(); err != nil {
+		return err
+	}
+	winner, duration, checksum := rep.Run()
+	if winner != rep.Winner || duration != rep.Duration || checksum != rep.Checksum {
+		return fmt.Errorf("replay diverged: winner %d/%d, duration %d/%d, checksum %x/%x",
+			winner, rep.Winner, duration, rep.Duration, checksum, rep.Checksum)
+	}
+	return nil
+}
+
+// 파일 형식 (big-endian)
+//
+//	["PRPL"][version u16][seed i64][catalog len][catalog]
+//	[players]{[id u16][team][name len][name][deck u32 x8]}
+//	[inputs u32]{[tick u32][kind][team][slot][x i16][wait]}
+//	[winner][duration u32][checksum u64]
+func (rep *Replay) WriteTo(w io.Writer) (int64, error) {
+	if err := rep.checkSize(); err != nil {
+		return 0, err
+	}
+	var data []byte
+	data = append(data, replayMagic...)
+	data = appendU16(data, ReplayVersion)
+	data = appendU64(data, uint64(rep.Seed))
+	data = append(data, byte(len(rep.Catalog)))
+	data = append(data, rep.Catalog...)
+	data = append(data, byte(len(rep.Players)))
+	for _, p := range rep.Players {
+		data = appendU16(data, p.ID)
+		data = append(data, p.Team, byte(len(p.Name)))
+		data = append(data, p.Name...)
+		for _, id := range p.Deck {
+			data = appendU32(data, id)
+		}
+	}
+	data = appendU32(data, uint32(len(rep.Inputs)))
+	for _, in := range rep.Inputs {
+		data = appendU32(data, in.Tick)
+		data = append(data, byte(in.Kind), in.Team, in.Slot)
+		data = appendU16(data, uint16(in.X))
+		data = append(data, in.Wait)
+	}
+	data = append(data, rep.Winner)
+	data = appendU32(data, rep.Duration)
+	data = appendU64(data, rep.Checksum)
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+// checkSize refuses what WriteTo could not write so that ReadReplay reads it back.
+func (rep *Replay) checkSize() error {
+	if len(rep.Catalog) > math.MaxUint8 {
+		return fmt.Errorf("catalog version of %d bytes: %w", len(rep.Catalog), ErrReplayTooLarge)
+	}
+	if len(rep.Players) == 0 || len(rep.Players) > 3 {
+		return fmt.Errorf("%d players: %w", len(rep.Players), ErrReplayTooLarge)
+	}
+	for _, p := range rep.Players {
+		if len(p.Name) > math.MaxUint8 {
+			return fmt.Errorf("player name of %d bytes: %w", len(p.Name), ErrReplayTooLarge)
+		}
+	}
+	if len(rep.Inputs) > maxReplayInputs {
+		return fmt.Errorf("%d inputs: %w", len(rep.Inputs), ErrReplayTooLarge)
+	}
+	return nil
+}
+
+func ReadReplay(r io.Reader) (*Replay, error) {
+	br := bufio.NewReader(r)
+	read := func(v interface{}) error {
+		return binary.Read(br, binary.BigEndian, v)
+	}
+
+	var head struct {
+		Magic   [4]byte
+		Version uint16
+		Seed    int64
+	}
+	if err := read(&head); err != nil {
+		return nil, err
+	}
+	if string(head.Magic[:]) != replayMagic {
+		return nil, ErrBadReplay
+	}
+	if head.Version < 1 || head.Version > ReplayVersion {
+		return nil, fmt.Errorf("replay version %d: %w", head.Version, ErrBadReplay)
+	}
+	var catalog []byte
+	if head.Version >= 2 {
+		var n byte
+		if err := read(&n); err != nil {
+			return nil, err
+		}
+		catalog = make([]byte, n)
+		if _, err := io.ReadFull(br, catalog); err != nil {
+			return nil, err
+		}
+	}
+	var players byte
+	if err := read(&players); err != nil {
+		return nil, err
+	}
+	if players == 0 || players > 3 {
+		return nil, fmt.Errorf("%d players: %w", players, ErrBadReplay)
+	}
+
+	rep := &Replay{Seed: head.Seed, Catalog: string(catalog)}
+	for i := 0; i < int(players); i++ {
+		var p ReplayPlayer
+		var nameLen byte
+		if err := read(&p.ID); err != nil {
+			return nil, err
+		}
+		if err := read(&p.Team); err != nil {
+			return nil, err
+		}
+		if err := read(&nameLen); err != nil {
+			return nil, err
+		}
+		name := make([]byte, nameLen)
+		if _, err := io.ReadFull(br, name); err != nil {
+			return nil, err
+		}
+		p.Name = string(name)
+		if err := read(&p.Deck); err != nil {
+			return nil, err
+		}
+		rep.Players = append(rep.Players, p)
+	}
+
+	var count uint32
+	if err := read(&count); err != nil {
+		return nil, err
+	}
+	if count > maxReplayInputs {
+		return nil, fmt.Errorf("%d inputs: %w", count, ErrBadReplay)
+	}
+	rep.Inputs = make([]Input, count)
+	for i := range rep.Inputs {
+		var raw struct {
+			Tick uint32
+			Kind byte
+			Team byte
+			Slot uint8
+			X    int16
+			Wait uint8
+		}
+		if err := read(&raw); err != nil {
+			return nil, err
+		}
+		rep.Inputs[i] = Input{raw.Tick, InputKind(raw.Kind), raw.Team, raw.Slot, raw.X, raw.Wait}
+	}
+
+	var tail struct {
+		Winner   byte
+		Duration uint32
+		Checksum uint64
+	}
+	if err := read(&tail); err != nil {
+		return nil, err
+	}
+	rep.Winner, rep.Duration, rep.Checksum = tail.Winner, tail.Duration, tail.Checksum
+	return rep, nil
+}
+
+func LoadReplay(path string) (*Replay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadReplay(f)
+}
+
+func (rep *Replay) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := rep.WriteTo(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	return f.Close()
+}
+
+func appendU16(data []byte, v uint16) []byte {
+	return append(data, byte(v>>8), byte(v))
+}
+
+func appendU32(data []byte, v uint32) []byte {
+	return append(data, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendU64(data []byte, v uint64) []byte {
+	return appendU32(appendU32(data, uint32(v>>32)), uint32(v))
+}
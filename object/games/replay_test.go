@@ -0,0 +1,168 @@
+package games
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// 팀마다 카드를 낼 수 있는 자리
+var scriptX = [3][]int16{
+	{-40, -10, 0, 20},
+	{60, -70, 75, -90},
+	{-30, 0, 30, 45},
+}
+
+// playScripted plays a whole match on a room without a ticker, every player
+// using a card every so often, and returns what it recorded.
+func playScripted(t *testing.T) *Replay {
+	t.Helper()
+	g := NewGame(1)
+	g.Close() // Step 은 여기서 직접 부른다
+
+	deck := CurrentCatalog().Starters()[:8]
+	for team := byte(0); team < 3; team++ {
+		p := PlayerSet(g, nil)
+		p.id = uint16(team) + 1
+		p.name = string(rune('a' + team))
+		p.team = team
+		if err := p.SetDeck(deck); err != nil {
+			t.Fatalf("team %d deck: %v", team, err)
+		}
+		g.players[g.PlayerCount] = p
+		g.PlayerCount++
+	}
+
+	var ended *Result
+	g.OnEnd = func(_ *Game, res Result) {
+		if ended != nil {
+			t.Fatal("match ended twice")
+		}
+		ended = &res
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	g.Start()
+	for g.status == StatusPlaying {
+		if g.tick%45 == 0 {
+			n := int(g.tick / 45)
+			for i := 0; i < g.PlayerCount; i++ {
+				p := g.players[i]
+				xs := scriptX[p.team]
+				p.useCard(uint8((n+i)%4), xs[(n+i)%len(xs)], uint8(n%3))
+			}
+		}
+		g.Step()
+	}
+	if ended == nil {
+		t.Fatal("match never ended")
+	}
+	if len(g.replay.Inputs) == 0 {
+		t.Fatal("no card was used")
+	}
+	return g.replay
+}
+
+func TestReplayRoundTripVerifies(t *testing.T) {
+	rep := playScripted(t)
+	t.Logf("%d inputs, winner %d after %d frames", len(rep.Inputs), rep.Winner, rep.Duration)
+
+	var buf bytes.Buffer
+	if _, err := rep.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadReplay(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Seed != rep.Seed || got.Catalog != rep.Catalog || len(got.Players) != len(rep.Players) || len(got.Inputs) != len(rep.Inputs) {
+		t.Fatalf("read back %+v, wrote %+v", got, rep)
+	}
+	for i := range rep.Inputs {
+		if got.Inputs[i] != rep.Inputs[i] {
+			t.Fatalf("input %d: read back %+v, wrote %+v", i, got.Inputs[i], rep.Inputs[i])
+		}
+	}
+	if err := got.Verify(); err != nil {
+		t.Fatal(err)
+	}
+
+	got.Checksum++
+	if err := got.Verify(); err == nil {
+		t.Error("Verify accepted a wrong checksum")
+	}
+}
+
+// replayHead is a version 2 file up to and including the player count.
+func replayHead(players byte) []byte {
+	data := append([]byte(replayMagic), 0, ReplayVersion)
+	data = appendU64(data, 42)
+	data = append(data, byte(len(CurrentCatalog().Version)))
+	data = append(data, CurrentCatalog().Version...)
+	return append(data, players)
+}
+
+func appendReplayPlayer(data []byte, team byte) []byte {
+	data = appendU16(data, uint16(team)+1)
+	data = append(data, team, 0)
+	for i := 0; i < 8; i++ {
+		data = appendU32(data, uint32(i+1))
+	}
+	return data
+}
+
+func TestReadReplayRejects(t *testing.T) {
+	full := replayHead(1)
+	full = appendReplayPlayer(full, 0)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no players", replayHead(0)},
+		{"four players", replayHead(4)},
+		{"truncated input count", append(append([]byte{}, full...), 0, 0)},
+		{"fewer inputs than counted", appendU32(append([]byte{}, full...), 2)},
+		{"bad magic", append([]byte("NOPE"), replayHead(1)[4:]...)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if rep, err := ReadReplay(bytes.NewReader(tt.data)); err == nil {
+				t.Errorf("read %+v", rep)
+			}
+		})
+	}
+
+	// 같은 앞부분에 입력 0 개와 끝맺음을 붙이면 읽힌다
+	ok := appendU32(append([]byte{}, full...), 0)
+	ok = append(ok, 0)
+	ok = appendU32(ok, 0)
+	ok = appendU64(ok, 0)
+	if _, err := ReadReplay(bytes.NewReader(ok)); err != nil {
+		t.Errorf("valid file: %v", err)
+	}
+}
+
+func TestReplayWriteToRefusesWhatItCannotRead(t *testing.T) {
+	players := []ReplayPlayer{{ID: 1}}
+	tests := []struct {
+		name string
+		rep  Replay
+	}{
+		{"long catalog version", Replay{Catalog: strings.Repeat("v", 256), Players: players}},
+		{"long name", Replay{Players: []ReplayPlayer{{ID: 1, Name: strings.Repeat("n", 256)}}}},
+		{"no players", Replay{}},
+		{"four players", Replay{Players: make([]ReplayPlayer, 4)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := tt.rep.WriteTo(io.Discard)
+			if !errors.Is(err, ErrReplayTooLarge) || n != 0 {
+				t.Errorf("wrote %d bytes, err %v", n, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package games
+
+import (
+	"log"
+	"sort"
+
+	"app/protocol"
+)
+
+const (
+	StatusReady     byte = iota // 대기실
+	StatusPlaying               // 게임 중
+	StatusCountdown             // 모두 준비, 시작 대기
+)
+
+// 모두 준비한 뒤 시작까지
+var CountdownFrames uint16 = 60 * 3
+
+var (
+	ErrTeamTaken   = protocol.Error{Code: protocol.ErrCodeTeamTaken, Message: "team is already taken"}
+	ErrNoTeam      = protocol.Error{Code: protocol.ErrCodeNoTeam, Message: "pick a team first"}
+	ErrNotHost     = protocol.Error{Code: protocol.ErrCodeNotHost, Message: "only the host can start"}
+	ErrNeedPlayers = protocol.Error{Code: protocol.ErrCodeNeedPlayers, Message: "need 3 players, one per team"}
+	ErrInGame      = protocol.Error{Code: protocol.ErrCodeInGame, Message: "match already started"}
+)
+
+// 아래 함수들은 g.mutex 를 잡은 상태에서 호출한다
+
+func (g *Game) ChangeTeam(p *Player, team byte) error {
+	if g.status == StatusPlaying {
+		return ErrInGame
+	}
+	if p.team == team {
+		return nil
+	}
+	if other := g.TeamPlayer(team); other != nil {
+		return ErrTeamTaken
+	}
+	if err := CurrentCatalog().Registry().Check(p.DeckIDs(), team); err != nil {
+		return err
+	}
+	p.team = team
+	p.ready = false
+	g.RoomChanged()
+	return nil
+}
+
+func (g *Game) SetReady(p *Player, ready bool) error {
+	if g.status == StatusPlaying {
+		return ErrInGame
+	}
+	if ready && p.team == protocol.NoTeam {
+		return ErrNoTeam
+	}
+	if ready {
+		if err := CurrentCatalog().CheckDeck(p.DeckIDs(), p.team); err != nil {
+			return err
+		}
+	}
+	p.ready = ready
+	g.RoomChanged()
+	return nil
+}
+
+// RequestStart starts the match at once when the host asks and every team is filled.
+func (g *Game) RequestStart(p *Player) error {
+	if g.status == StatusPlaying {
+		return ErrInGame
+	}
+	if p != g.host {
+		return ErrNotHost
+	}
+	if err := g.CanStart(); err != nil {
+		return err
+	}
+	g.Start()
+	return nil
+}
+
+func (g *Game) CanStart() error {
+	if g.PlayerCount < 3 {
+		return ErrNeedPlayers
+	}
+	var teamOn [3]bool
+	for i := 0; i < g.PlayerCount; i++ {
+		team := g.players[i].team
+		if team >= 3 || teamOn[team] {
+			return ErrNeedPlayers
+		}
+		teamOn[team] = true
+	}
+	c := CurrentCatalog()
+	for i := 0; i < g.PlayerCount; i++ {
+		p := g.players[i]
+		err := c.CheckDeck(p.DeckIDs(), p.team)
+		// 고른 뒤에 회수된 카드가 있으면 못 쓴다
+		if err == nil && p.owned != nil {
+			err = checkOwned(p.DeckIDs(), p.owned)
+		}
+		if err != nil {
+			e := err.(protocol.Error)
+			e.Message = p.name + ": " + e.Message
+			return e
+		}
+	}
+	return nil
+}
+
+// Revoke takes card id out of the cards account was seen to own, so a deck
+// already holding it cannot start a match.
+func (g *Game) Revoke(account string, id uint32) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	changed := false
+	for i := 0; i < g.PlayerCount; i++ {
+		if p := g.players[i]; p.account == account && p.owned[id] {
+			delete(p.owned, id)
+			changed = true
+		}
+	}
+	if changed {
+		g.RoomChanged()
+	}
+}
+
+func (g *Game) allReady() bool {
+	for i := 0; i < g.PlayerCount; i++ {
+		if !g.players[i].ready {
+			return false
+		}
+	}
+	return true
+}
+
+// RoomChanged starts or cancels the countdown and tells everyone the new room state.
+func (g *Game) RoomChanged() {
+	if g.status == StatusPlaying {
+		return
+	}
+	if g.CanStart() == nil && g.allReady() {
+		if g.status != StatusCountdown {
+			g.status = StatusCountdown
+			g.countdown = CountdownFrames
+			log.Println("countdown", g.id)
+		}
+	} else {
+		g.status = StatusReady
+		g.countdown = 0
+	}
+	g.Broadcast(g.RoomState().Encode())
+}
+
+func (g *Game) RoomState() protocol.RoomState {
+	s := protocol.RoomState{
+		Status:    g.status,
+		Countdown: g.countdown,
+	}
+	if g.host != nil {
+		s.Host = g.host.id
+	}
+	for i := 0; i < g.PlayerCount; i++ {
+		p := g.players[i]
+		s.Players = append(s.Players, protocol.ReadyState{ID: p.id, Team: p.team, Ready: p.ready})
+	}
+	return s
+}
+
+func (g *Game) tickCountdown() {
+	if g.countdown > 0 {
+		g.countdown--
+		if g.countdown%60 == 0 {
+			g.Broadcast(g.RoomState().Encode())
+		}
+		return
+	}
+	g.Start()
+}
+
+// 팀 순서대로 자리 정리, players[0] 이 지구 주인이 된다
+func (g *Game) sortByTeam() {
+	sort.Slice(g.players[:g.PlayerCount], func(i, j int) bool {
+		return g.players[i].team < g.players[j].team
+	})
+}
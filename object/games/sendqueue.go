@@ -0,0 +1,174 @@
+package games
+
+import (
+	"encoding/binary"
+	"expvar"
+	"log"
+	"sync"
+	"time"
+
+	"app/protocol"
+)
+
+type OverflowPolicy byte
+
+const (
+	OverflowDrop       OverflowPolicy = iota // 새 메시지를 버린다
+	OverflowDisconnect                       // 연결을 끊는다
+)
+
+type QueueConfig struct {
+	Size   int
+	Policy OverflowPolicy
+}
+
+var SendQueueConfig = QueueConfig{
+	Size:   128,
+	Policy: OverflowDisconnect,
+}
+
+// 전체 플레이어 합계, /debug/vars 로 볼 수 있다
+var (
+	queueSent        = expvar.NewInt("send_queue_sent")
+	queueDropped     = expvar.NewInt("send_queue_dropped")
+	queueCoalesced   = expvar.NewInt("send_queue_coalesced")
+	queueDisconnects = expvar.NewInt("send_queue_disconnects")
+	queueMaxDepth    = expvar.NewInt("send_queue_max_depth")
+)
+
+type QueueStats struct {
+	Depth     int
+	MaxDepth  int
+	Sent      int64
+	Dropped   int64
+	Coalesced int64
+}
+
+// 최신 것 하나만 의미가 있는 상태 메시지. OutPlayerState 는 관전자가 모든 플레이어 것을 받으므로 id 별로
+func coalesceKey(data []byte) (uint32, bool) {
+	switch data[0] {
+	case protocol.OutPlayers, protocol.OutWorld, protocol.OutSnapshot:
+		return uint32(data[0]), true
+	case protocol.OutPlayerState:
+		if len(data) < 3 {
+			return 0, false
+		}
+		return uint32(data[0])<<16 | uint32(binary.BigEndian.Uint16(data[1:3])), true
+	}
+	return 0, false
+}
+
+// sendQueue is drained by its own writer goroutine so a slow client never blocks Game.Frame.
+type sendQueue struct {
+	conn   protocol.Conn
+	config QueueConfig
+
+	mutex  sync.Mutex
+	cond   *sync.Cond
+	items  [][]byte
+	closed bool
+	stats  QueueStats
+}
+
+func newSendQueue(conn protocol.Conn, config QueueConfig) *sendQueue {
+	q := &sendQueue{
+		conn:   conn,
+		config: config,
+	}
+	q.cond = sync.NewCond(&q.mutex)
+	return q
+}
+
+func (q *sendQueue) Push(data []byte) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.closed || len(data) == 0 {
+		return
+	}
+
+	// 예전 것은 지우고 새 것은 맨 뒤에, 나중에 넣은 메시지(End 등)를 앞지르지 않게
+	if key, ok := coalesceKey(data); ok {
+		for i := len(q.items) - 1; i >= 0; i-- {
+			if k, ok := coalesceKey(q.items[i]); ok && k == key {
+				q.items = append(q.items[:i], q.items[i+1:]...)
+				q.items = append(q.items, data)
+				q.stats.Coalesced++
+				queueCoalesced.Add(1)
+				q.cond.Signal()
+				return
+			}
+		}
+	}
+
+	if len(q.items) >= q.config.Size {
+		switch q.config.Policy {
+		case OverflowDisconnect:
+			log.Println("send queue overflow, disconnect", q.conn.RemoteAddr())
+			queueDisconnects.Add(1)
+			q.closed = true
+			q.items = nil
+			q.cond.Broadcast()
+			q.conn.Close()
+		default:
+			q.stats.Dropped++
+			queueDropped.Add(1)
+		}
+		return
+	}
+
+	q.items = append(q.items, data)
+	if len(q.items) > q.stats.MaxDepth {
+		q.stats.MaxDepth = len(q.items)
+		if int64(q.stats.MaxDepth) > queueMaxDepth.Value() {
+			queueMaxDepth.Set(int64(q.stats.MaxDepth))
+		}
+	}
+	q.cond.Signal()
+}
+
+// Close stops accepting messages; the writer flushes what is left and closes the conn.
+func (q *sendQueue) Close() {
+	q.mutex.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mutex.Unlock()
+}
+
+func (q *sendQueue) Stats() QueueStats {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	s := q.stats
+	s.Depth = len(q.items)
+	return s
+}
+
+func (q *sendQueue) Writer() {
+	defer q.conn.Close()
+	for {
+		q.mutex.Lock()
+		for len(q.items) == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		if len(q.items) == 0 {
+			q.mutex.Unlock()
+			return
+		}
+		data := q.items[0]
+		q.items[0] = nil
+		q.items = q.items[1:]
+		q.mutex.Unlock()
+
+		q.conn.SetWriteDeadline(time.Now().Add(Heartbeat.WriteTimeout))
+		if err := q.conn.WriteFrame(data); err != nil {
+			log.Println(err)
+			q.Close()
+			return
+		}
+
+		q.mutex.Lock()
+		q.stats.Sent++
+		q.mutex.Unlock()
+		queueSent.Add(1)
+	}
+}
@@ -0,0 +1,154 @@
+package games
+
+import (
+	"crypto/rand"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"app/protocol"
+)
+
+// 접속이 끊긴 플레이어의 자리를 비워두는 시간
+var ReconnectGrace = 30 * time.Second
+
+var ErrHandshake = errors.New("handshake failed")
+
+var (
+	sessions      = map[protocol.SessionToken]*Player{}
+	sessionsMutex sync.Mutex
+)
+
+// Welcome reads the first frame of a new connection, which must be a Hello, and answers it.
+func Welcome(c protocol.Conn) (protocol.Hello, protocol.HelloReply, error) {
+	c.SetReadDeadline(time.Now().Add(Heartbeat.ReadTimeout))
+	c.SetWriteDeadline(time.Now().Add(Heartbeat.WriteTimeout))
+	data, err := c.ReadFrame()
+	if err != nil {
+		return protocol.Hello{}, protocol.HelloReply{}, err
+	}
+	msg, err := protocol.Decode(data)
+	hello, ok := msg.(protocol.Hello)
+	if err != nil || !ok {
+		reply := protocol.HelloReply{
+			Version: protocol.CurrentVersion,
+			Reason:  "handshake required",
+		}
+		c.WriteFrame(reply.Encode())
+		return protocol.Hello{}, reply, ErrHandshake
+	}
+
+	reply := protocol.Negotiate(hello)
+	if err := c.WriteFrame(reply.Encode()); err != nil {
+		return hello, reply, err
+	}
+	if !reply.Accepted {
+		log.Println("handshake rejected :", reply.Reason)
+		return hello, reply, ErrHandshake
+	}
+	return hello, reply, nil
+}
+
+func newSession(p *Player) {
+	sessionsMutex.Lock()
+	defer sessionsMutex.Unlock()
+	for {
+		if _, err := rand.Read(p.session[:]); err != nil {
+			log.Println(err)
+		}
+		if _, ok := sessions[p.session]; !ok && !p.session.IsZero() {
+			break
+		}
+	}
+	sessions[p.session] = p
+}
+
+func forgetSession(p *Player) {
+	sessionsMutex.Lock()
+	if sessions[p.session] == p {
+		delete(sessions, p.session)
+	}
+	sessionsMutex.Unlock()
+}
+
+func (p *Player) sendSession(resumed bool) {
+	p.write(protocol.Session{
+		Token:   p.session,
+		Grace:   uint16(ReconnectGrace / time.Second),
+		Resumed: resumed,
+	}.Encode())
+}
+
+// Resume re-attaches c to the player owning token. It returns false if the
+// token is unknown or its grace window already ran out.
+func Resume(token protocol.SessionToken, c protocol.Conn, reply protocol.HelloReply) bool {
+	if token.IsZero() {
+		return false
+	}
+
+	sessionsMutex.Lock()
+	p, ok := sessions[token]
+	if !ok {
+		sessionsMutex.Unlock()
+		return false
+	}
+	g := p.game
+	g.mutex.Lock()
+	if p.graceTimer != nil {
+		p.graceTimer.Stop()
+		p.graceTimer = nil
+	}
+	if p.queue != nil { // 이전 연결이 아직 살아있다면 끊는다
+		p.queue.Close()
+	}
+	p.attach(c, reply)
+	p.sendSession(true)
+	if g.status == StatusPlaying {
+		p.write([]byte{protocol.OutStart})
+	}
+	g.mutex.Unlock()
+	sessionsMutex.Unlock()
+
+	log.Println(p.name + " reconnected")
+	go p.ConnHandler()
+	return true
+}
+
+// Disconnect keeps p's slot for ReconnectGrace before removing it from the game.
+func (g *Game) Disconnect(p *Player, c protocol.Conn) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if p.conn != c { // 이미 다른 연결로 재접속했다
+		return
+	}
+	p.conn = nil
+	p.queue = nil
+	if p.spectator {
+		g.removeSpectator(p)
+		return
+	}
+	log.Println(p.name + " disconnected")
+
+	p.graceTimer = time.AfterFunc(ReconnectGrace, func() {
+		g.expire(p)
+	})
+}
+
+func (g *Game) expire(p *Player) {
+	sessionsMutex.Lock()
+	g.mutex.Lock()
+	gone := p.conn == nil
+	if gone {
+		p.graceTimer = nil
+		if sessions[p.session] == p {
+			delete(sessions, p.session)
+		}
+	}
+	g.mutex.Unlock()
+	sessionsMutex.Unlock()
+
+	if gone {
+		g.Left(p)
+	}
+}
@@ -0,0 +1,90 @@
+package games
+
+import (
+	"app/protocol"
+)
+
+const (
+	snapshotHistory  = 64  // 클라이언트 ack 를 기다리는 동안 보관할 스냅샷 수
+	keyframeInterval = 120 // ack 와 상관없이 이 틱마다 키프레임
+)
+
+type snapshotKey struct {
+	enc  protocol.Encoding
+	base uint32
+}
+
+func (g *Game) TakeSnapshot() *protocol.Snapshot {
+	g.snapshotSeq++
+	if g.snapshotSeq == 0 { // 0 은 키프레임 표시
+		g.snapshotSeq++
+	}
+	s := &protocol.Snapshot{Seq: g.snapshotSeq}
+	for _, unit := range g.units {
+		s.Entities = append(s.Entities, unit.State())
+	}
+	for _, proj := range g.projectiles {
+		s.Entities = append(s.Entities, proj.State())
+	}
+	g.snapshots[s.Seq%snapshotHistory] = s
+	return s
+}
+
+func (g *Game) ResetSnapshots() {
+	g.snapshots = [snapshotHistory]*protocol.Snapshot{}
+	for _, p := range g.Viewers() {
+		p.acked = 0
+	}
+}
+
+// snapshotBase returns the last snapshot p acknowledged, or nil when p needs a keyframe.
+func (g *Game) snapshotBase(p *Player, cur *protocol.Snapshot) *protocol.Snapshot {
+	if p.acked == 0 || cur.Seq-p.lastKeyframe >= keyframeInterval || cur.Seq-p.acked >= snapshotHistory {
+		return nil
+	}
+	base := g.snapshots[p.acked%snapshotHistory]
+	if base == nil || base.Seq != p.acked {
+		return nil
+	}
+	return base
+}
+
+func (g *Game) SendWorld() {
+	cur := g.TakeSnapshot()
+
+	// 같은 인코딩, 같은 base 인 플레이어끼리는 한 번만 만든다
+	world := map[snapshotKey][]byte{}
+	full := map[protocol.Encoding][]byte{}
+	for _, p := range g.Viewers() {
+		if !p.caps.Has(protocol.CapDeltaSnapshot) {
+			data, ok := full[p.encoding]
+			if !ok {
+				data = g.WorldData(p.encoding)
+				full[p.encoding] = data
+			}
+			p.Send(data)
+			continue
+		}
+
+		base := g.snapshotBase(p, cur)
+		key := snapshotKey{enc: p.encoding}
+		if base != nil {
+			key.base = base.Seq
+		} else {
+			p.lastKeyframe = cur.Seq
+		}
+		data, ok := world[key]
+		if !ok {
+			data = protocol.AppendSnapshot(nil, p.encoding, base, cur)
+			world[key] = data
+		}
+		p.Send(data)
+	}
+}
+
+func (p *Player) Ack(seq uint32) {
+	if seq <= p.acked || seq > p.game.snapshotSeq {
+		return
+	}
+	p.acked = seq
+}
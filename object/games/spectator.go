@@ -0,0 +1,61 @@
+package games
+
+import (
+	"log"
+
+	"app/protocol"
+)
+
+// 방마다 관전자 수 제한
+var MaxSpectators = 8
+
+var ErrSpectatorsFull = protocol.Error{Code: protocol.ErrCodeRoomFull, Message: "spectator slots are full"}
+
+// Spectate attaches c as a spectator. Spectators see everything the players see
+// but do not take a slot and cannot play cards or change team and deck.
+func (g *Game) Spectate(c protocol.Conn, reply protocol.HelloReply, greeting ...[]byte) error {
+	g.mutex.Lock()
+	if g.closed {
+		g.mutex.Unlock()
+		return ErrRoomClosed
+	}
+	if len(g.spectators) >= MaxSpectators {
+		g.mutex.Unlock()
+		return ErrSpectatorsFull
+	}
+	p := PlayerSet(g, nil)
+	p.spectator = true
+	p.attach(c, reply)
+	for _, data := range greeting {
+		p.write(data)
+	}
+	g.spectators = append(g.spectators, p)
+	if g.status == StatusPlaying {
+		p.write([]byte{protocol.OutStart})
+	}
+	if g.playback != nil {
+		p.write(g.replayState().Encode())
+	}
+	g.mutex.Unlock()
+
+	log.Println("spectator joined", c.RemoteAddr())
+	go p.ConnHandler()
+	return nil
+}
+
+func (g *Game) SpectatorCount() int {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return len(g.spectators)
+}
+
+// removeSpectator expects g.mutex to be held.
+func (g *Game) removeSpectator(p *Player) {
+	for i, s := range g.spectators {
+		if s == p {
+			g.spectators = append(g.spectators[:i], g.spectators[i+1:]...)
+			break
+		}
+	}
+	log.Println("spectator left", p.name)
+}
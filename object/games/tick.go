@@ -0,0 +1,51 @@
+package games
+
+import (
+	"expvar"
+	"fmt"
+	"time"
+)
+
+type TickConfig struct {
+	SimRate    int // 초당 시뮬레이션 프레임, 게임 속도이므로 바꾸지 않는다
+	SendRate   int // 초당 상태 전송
+	MaxCatchUp int // 한 번 깨어났을 때 따라잡을 최대 프레임
+}
+
+var Tick = TickConfig{
+	SimRate:    60,
+	SendRate:   30,
+	MaxCatchUp: 5,
+}
+
+var (
+	simOverruns = expvar.NewInt("sim_overruns") // 늦어서 몰아서 돌린 프레임
+	simDropped  = expvar.NewInt("sim_dropped")  // 너무 늦어서 버린 프레임
+)
+
+// Validate refuses a SendRate that does not divide SimRate evenly;
+// sendEvery would otherwise round it to another rate.
+func (t TickConfig) Validate() error {
+	if t.SendRate < 1 || t.SendRate > t.SimRate || t.SimRate%t.SendRate != 0 {
+		return fmt.Errorf("send rate %d must divide the sim rate %d evenly", t.SendRate, t.SimRate)
+	}
+	return nil
+}
+
+// sendEvery is how many sim frames pass between two state sends.
+func (t TickConfig) sendEvery() uint {
+	rate := t.SendRate
+	if rate < 1 {
+		rate = 1
+	}
+	if rate > t.SimRate {
+		rate = t.SimRate
+	}
+	return uint(t.SimRate / rate)
+}
+
+// run starts the room's ticker and frame loop; Close stops both.
+func (g *Game) run() {
+	g.ticker = time.NewTicker(time.Second / time.Duration(Tick.SimRate))
+	go g.Frame()
+}
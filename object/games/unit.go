@@ -5,6 +5,8 @@ import (
 	"log"
 	"math"
 
+	"app/protocol"
+
 	quadtree "github.com/ybs1164/quadtree-go"
 )
 
@@ -19,9 +21,12 @@ type IUnit interface {
 	IsPoisoned() bool
 	Poisoned()
 	Move(float64, float64)
-	Data() []byte
+	State() protocol.EntityState
+	Data(protocol.Encoding) []byte
 	IsDead() bool
 	Death()
+
+	clone(*cloner) IUnit // 키프레임용, target 은 c 가 나중에 잇는다
 }
 
 // todo : Unit Status
@@ -43,6 +48,15 @@ type Unit struct {
 	isReverse bool
 }
 
+// define copies the stats every unit has from its catalog entry.
+func (u *Unit) define(d *UnitDef) {
+	u.typeid = d.Type
+	u.maxHealth = d.Health
+	u.health = d.Health
+	u.Width = d.Width
+	u.Height = d.Height
+}
+
 func (u *Unit) HitBox() *quadtree.Bounds {
 	return &u.Bounds
 }
@@ -93,7 +107,9 @@ func (u Unit) IsPoisoned() bool {
 }
 
 func (u *Unit) Poisoned() {
-	u.owner = u.owner.game.players[2]
+	if semo := u.owner.game.TeamPlayer(2); semo != nil {
+		u.owner = semo
+	}
 	u.team = 2
 	u.poison = u.health
 }
@@ -135,22 +151,26 @@ func (u *Unit) IsDead() bool {
 	return u.health <= 0
 }
 
-func (u Unit) Data() []byte {
+func (u Unit) State() protocol.EntityState {
+	return protocol.EntityState{
+		Kind:      protocol.KindUnit,
+		ID:        u.id,
+		TypeID:    u.typeid,
+		Team:      u.team,
+		X:         u.X,
+		Y:         u.Y,
+		Health:    u.health,
+		MaxHealth: u.maxHealth,
+		Poison:    u.poison,
+		Status:    u.Status(),
+	}
+}
+
+func (u Unit) Data(enc protocol.Encoding) []byte {
 	if !u.isVisible {
 		return []byte{}
 	}
-	var data []byte = make([]byte, 34)
-	binary.BigEndian.PutUint16(data[0:2], u.id)
-	binary.BigEndian.PutUint16(data[2:4], u.typeid)
-	data[4] = u.team
-	// todo : pos set
-	binary.BigEndian.PutUint64(data[5:13], math.Float64bits(u.X))
-	binary.BigEndian.PutUint64(data[13:21], math.Float64bits(u.Y))
-	binary.BigEndian.PutUint32(data[21:25], u.health)
-	binary.BigEndian.PutUint32(data[25:29], u.maxHealth)
-	binary.BigEndian.PutUint32(data[29:33], u.poison)
-	data[33] = u.Status()
-	return data
+	return protocol.AppendEntity(nil, enc, u.State())
 }
 
 func (u Unit) Status() byte {
@@ -169,21 +189,20 @@ type Earth struct {
 	Unit
 }
 
-func NewEarth() *Earth {
+func NewEarth(d *UnitDef) *Earth {
 	unit := Earth{}
-	unit.typeid = 5
-
-	unit.maxHealth = 5000
-	unit.health = 5000
+	unit.define(d)
 
-	unit.X = -3
-
-	unit.Width = 8.25
-	unit.Height = 4.56
+	unit.X = d.X
 
 	return &unit
 }
 
+func (e *Earth) clone(c *cloner) IUnit {
+	u := *e
+	return &u
+}
+
 func (e *Earth) Death() {
 	e.Unit.Death()
 	e.owner.game.End(1)
@@ -192,30 +211,33 @@ func (e *Earth) Death() {
 // Flask
 type Flask struct {
 	Unit
-	time int
+	time   int
+	period int
+	energy uint16
 }
 
-func NewFlask() *Flask {
+func NewFlask(d *UnitDef) *Flask {
 	unit := Flask{}
-	unit.typeid = 0
+	unit.define(d)
 
-	unit.maxHealth = 350
-	unit.health = 350
-
-	unit.Width = 1.62
-	unit.Height = 2.71
-
-	unit.time = 60 * 5
+	unit.period = d.Period
+	unit.time = d.Period
+	unit.energy = d.Energy
 
 	return &unit
 }
 
+func (f *Flask) clone(c *cloner) IUnit {
+	u := *f
+	return &u
+}
+
 func (f *Flask) Frame() {
 	f.Unit.Frame()
 	f.time--
 	if f.time <= 0 {
-		f.owner.GetEnergy(1)
-		f.time = 60 * 5
+		f.owner.GetEnergy(f.energy)
+		f.time = f.period
 	}
 }
 
@@ -225,21 +247,20 @@ type Note struct {
 	subEnergy uint16
 }
 
-func NewNote() *Note {
+func NewNote(d *UnitDef) *Note {
 	unit := Note{}
-	unit.typeid = 1
-
-	unit.maxHealth = 100
-	unit.health = 100
-
-	unit.Width = 2.25
-	unit.Height = 2.92
+	unit.define(d)
 
-	unit.subEnergy = 1
+	unit.subEnergy = d.Energy
 
 	return &unit
 }
 
+func (n *Note) clone(c *cloner) IUnit {
+	u := *n
+	return &u
+}
+
 func (n *Note) Run(p *Player, id uint16) {
 	n.Unit.Run(p, id)
 	for i := range p.deck {
@@ -274,21 +295,20 @@ type Bag struct {
 	addEnergy uint16
 }
 
-func NewBag() *Bag {
+func NewBag(d *UnitDef) *Bag {
 	unit := Bag{}
-	unit.typeid = 2
-
-	unit.maxHealth = 150
-	unit.health = 150
+	unit.define(d)
 
-	unit.Width = 3.07
-	unit.Height = 3.59
-
-	unit.addEnergy = 1
+	unit.addEnergy = d.Energy
 
 	return &unit
 }
 
+func (b *Bag) clone(c *cloner) IUnit {
+	u := *b
+	return &u
+}
+
 func (b *Bag) Run(p *Player, id uint16) {
 	b.Unit.Run(p, id)
 	p.maxEnergy += b.addEnergy
@@ -310,19 +330,18 @@ type Pen struct {
 	Unit
 }
 
-func NewPen() *Pen {
+func NewPen(d *UnitDef) *Pen {
 	unit := Pen{}
-	unit.typeid = 3
-
-	unit.maxHealth = 500
-	unit.health = 500
-
-	unit.Width = 1
-	unit.Height = 1
+	unit.define(d)
 
 	return &unit
 }
 
+func (p *Pen) clone(c *cloner) IUnit {
+	u := *p
+	return &u
+}
+
 //
 type BigPencil struct {
 	Unit
@@ -332,30 +351,32 @@ type BigPencil struct {
 
 	damage   uint32
 	distance float64
+	radius   uint32
 
 	target *IUnit
 }
 
-func NewBigPencil() *BigPencil {
+func NewBigPencil(d *UnitDef) *BigPencil {
 	unit := BigPencil{}
-	unit.typeid = 4
+	unit.define(d)
 
-	unit.maxHealth = 1000
-	unit.health = 1000
+	unit.damage = d.Damage
 
-	unit.Width = 6.52
-	unit.Height = 1.49
+	unit.mcooltime = d.Cooldown
+	unit.waittime = d.Wait
 
-	unit.damage = 250
-
-	unit.mcooltime = 120
-	unit.waittime = 30
-
-	unit.distance = 1
+	unit.distance = d.Distance
+	unit.radius = d.Radius
 
 	return &unit
 }
 
+func (b *BigPencil) clone(c *cloner) IUnit {
+	u := *b
+	c.link(&u.target, b.target)
+	return &u
+}
+
 func (b *BigPencil) Run(p *Player, id uint16) {
 	b.Unit.Run(p, id)
 	switch b.team {
@@ -412,7 +433,7 @@ func (b *BigPencil) Frame() {
 	if b.target != nil {
 		if !(*b.target).IsDead() && b.team != (*b.target).Team() && (*b.target).HitBox().Intersects(detectBound) {
 			if b.cooltime == 0 {
-				m := NewDamageMagic(b.damage, 3)
+				m := NewDamageMagic(b.damage, b.radius)
 				if b.isReverse {
 					m.Run(b.owner, b.X+b.Width, b.Y)
 				} else {
@@ -437,32 +458,30 @@ type Sharpener struct {
 	waittime  uint8
 
 	distance float64
-	speed    float64
-	damage   uint32
+	bullet   BulletDef
 
 	target *IUnit
 }
 
-func NewSharpener() *Sharpener {
+func NewSharpener(d *UnitDef) *Sharpener {
 	unit := Sharpener{}
-	unit.typeid = 6
-
-	unit.maxHealth = 700
-	unit.health = 700
+	unit.define(d)
 
-	unit.Width = 3.49
-	unit.Height = 2.34
+	unit.distance = d.Distance
+	unit.bullet = *d.Bullet
 
-	unit.distance = 15
-	unit.speed = .5
-	unit.damage = 80
-
-	unit.mcooltime = 60
-	//unit.waittime = 30
+	unit.mcooltime = d.Cooldown
+	unit.waittime = d.Wait
 
 	return &unit
 }
 
+func (s *Sharpener) clone(c *cloner) IUnit {
+	u := *s
+	c.link(&u.target, s.target)
+	return &u
+}
+
 func (s *Sharpener) Run(p *Player, id uint16) {
 	s.Unit.Run(p, id)
 	switch s.team {
@@ -514,7 +533,7 @@ func (s *Sharpener) Frame() {
 		if s.target != nil {
 			if !(*s.target).IsDead() && s.team != (*s.target).Team() && (*s.target).HitBox().Intersects(detectBound) {
 				var dx float64 = -1
-				obj := NewBullet(s.damage, s.speed, 300, 12)
+				obj := NewBullet(s.bullet.Damage, s.bullet.Speed, s.bullet.Life, s.bullet.Type)
 				obj.angle = math.Pi
 				if s.isReverse {
 					dx = s.Width + 1
@@ -545,22 +564,21 @@ type Alarm struct {
 	distance uint32
 }
 
-func NewAlarm() *Alarm {
+func NewAlarm(d *UnitDef) *Alarm {
 	unit := Alarm{}
-	unit.typeid = 7
+	unit.define(d)
 
-	unit.maxHealth = 200
-	unit.health = 200
-
-	unit.Width = 1.69
-	unit.Height = 1.96
-
-	unit.damage = 1
-	unit.distance = 5
+	unit.damage = d.Damage
+	unit.distance = d.Radius
 
 	return &unit
 }
 
+func (a *Alarm) clone(c *cloner) IUnit {
+	u := *a
+	return &u
+}
+
 func (a *Alarm) Frame() {
 	a.Unit.Frame()
 
@@ -573,25 +591,26 @@ type Dictionary struct {
 	Unit
 
 	healPercent float64
+	radius      uint32
 }
 
-func NewDictionary() *Dictionary {
+func NewDictionary(d *UnitDef) *Dictionary {
 	unit := Dictionary{}
-	unit.typeid = 8
-
-	unit.maxHealth = 2000
-	unit.health = 2000
-
-	unit.Width = 1.06
-	unit.Height = 3.04
+	unit.define(d)
 
-	unit.healPercent = 0.2
+	unit.healPercent = d.Percent
+	unit.radius = d.Radius
 
 	return &unit
 }
 
+func (dic *Dictionary) clone(c *cloner) IUnit {
+	u := *dic
+	return &u
+}
+
 func (dic *Dictionary) GetDamage(d uint32, t byte) {
-	m := NewHealMagic(uint32(float64(d)*dic.healPercent), 10)
+	m := NewHealMagic(uint32(float64(d)*dic.healPercent), dic.radius)
 	m.Run(dic.owner, dic.X+dic.Width/2, dic.Y)
 
 	dic.Unit.GetDamage(d, t)
@@ -609,24 +628,25 @@ type PaintBrush struct {
 	target *IUnit
 }
 
-func NewPaintBrush() *PaintBrush {
+func NewPaintBrush(d *UnitDef) *PaintBrush {
 	unit := PaintBrush{}
-	unit.typeid = 9
-
-	unit.maxHealth = 400
-	unit.health = 400
+	unit.define(d)
 
-	unit.Width = 4.08
-	unit.Height = 1.29
+	unit.mcooltime = d.Cooldown
+	unit.waittime = d.Wait
 
-	unit.mcooltime = 30
-
-	unit.heal = 50
-	unit.distance = 2
+	unit.heal = d.Heal
+	unit.distance = d.Distance
 
 	return &unit
 }
 
+func (pb *PaintBrush) clone(c *cloner) IUnit {
+	u := *pb
+	c.link(&u.target, pb.target)
+	return &u
+}
+
 func (pb *PaintBrush) Run(p *Player, id uint16) {
 	pb.Unit.Run(p, id)
 	switch pb.team {
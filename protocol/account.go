@@ -0,0 +1,46 @@
+package protocol
+
+import "fmt"
+
+const (
+	MinSecretLength = 8
+	MaxSecretLength = 64
+)
+
+// Login claims Name with Secret the first time it is used, and proves it afterwards.
+// Ratings, saved decks and collections belong to the logged in name only.
+type Login struct {
+	Name   string
+	Secret string
+}
+
+func (Login) Type() byte { return TypeLogin }
+
+// [name][secret]
+func DecodeLogin(b []byte) (Login, error) {
+	var m Login
+	var err error
+	if m.Name, b, err = readString(b, MaxNameLength); err != nil {
+		return Login{}, fmt.Errorf("login: name: %w", err)
+	}
+	if m.Name == "" {
+		return Login{}, fmt.Errorf("login: name: %w", ErrInvalidValue)
+	}
+	if m.Secret, _, err = readString(b, MaxSecretLength); err != nil {
+		return Login{}, fmt.Errorf("login: secret: %w", err)
+	}
+	if len(m.Secret) < MinSecretLength {
+		return Login{}, fmt.Errorf("login: secret shorter than %d bytes: %w", MinSecretLength, ErrInvalidValue)
+	}
+	return m, nil
+}
+
+// LoggedIn confirms a Login.
+type LoggedIn struct {
+	Name string
+}
+
+// [name]
+func (m LoggedIn) Encode() []byte {
+	return appendString([]byte{OutLoggedIn}, m.Name)
+}
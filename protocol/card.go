@@ -0,0 +1,41 @@
+package protocol
+
+type Rarity byte
+
+const (
+	RarityCommon Rarity = iota
+	RarityRare
+	RarityEpic
+	RarityLegendary
+)
+
+type CardsRequest struct{}
+
+func (CardsRequest) Type() byte { return TypeCards }
+
+type CardInfo struct {
+	ID     uint32
+	Name   string
+	Cost   uint16
+	Rarity Rarity
+	Teams  byte // bit i 가 켜져 있으면 팀 i 가 쓸 수 있다
+}
+
+// CardList is every card the server currently knows, ordered by id.
+type CardList struct {
+	Version string
+	Cards   []CardInfo
+}
+
+// [version][count u16]{[id u32][cost u16][rarity][teams][name]}
+func (l CardList) Encode() []byte {
+	data := appendString([]byte{OutCardList}, l.Version)
+	data = appendUint16(data, uint16(len(l.Cards)))
+	for _, c := range l.Cards {
+		data = appendUint32(data, c.ID)
+		data = appendUint16(data, c.Cost)
+		data = append(data, byte(c.Rarity), c.Teams)
+		data = appendString(data, c.Name)
+	}
+	return data
+}
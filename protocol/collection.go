@@ -0,0 +1,21 @@
+package protocol
+
+type CollectionRequest struct{}
+
+func (CollectionRequest) Type() byte { return TypeCollection }
+
+// Collection is the cards a player owns and their experience so far.
+type Collection struct {
+	Experience uint32
+	Cards      []uint32 // 오름차순
+}
+
+// [experience u32][count u16]{[id u32]}
+func (c Collection) Encode() []byte {
+	data := appendUint32([]byte{OutCollection}, c.Experience)
+	data = appendUint16(data, uint16(len(c.Cards)))
+	for _, id := range c.Cards {
+		data = appendUint32(data, id)
+	}
+	return data
+}
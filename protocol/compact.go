@@ -0,0 +1,149 @@
+package protocol
+
+import (
+	"math"
+)
+
+// 필드는 ±80 밖이면 죽으므로 int16 / 256 (±128) 로 충분하다
+const (
+	PositionScale = 256
+	AngleSteps    = 256
+	RatioSteps    = 255
+
+	MaxPositionError = 0.5 / PositionScale
+	MaxAngleError    = math.Pi / AngleSteps
+)
+
+// compact flags byte : team 2bit | kind 1bit | status 5bit
+const (
+	flagTeamMask   = 0x03
+	flagProjectile = 0x04
+	flagStatusBits = 3
+)
+
+func QuantizePosition(v float64) int16 {
+	q := math.Round(v * PositionScale)
+	if q > math.MaxInt16 {
+		q = math.MaxInt16
+	} else if q < math.MinInt16 {
+		q = math.MinInt16
+	}
+	return int16(q)
+}
+
+func DequantizePosition(q int16) float64 {
+	return float64(q) / PositionScale
+}
+
+func QuantizeAngle(a float64) byte {
+	turn := math.Mod(a/(2*math.Pi), 1)
+	if turn < 0 {
+		turn++
+	}
+	return byte(int(math.Round(turn*AngleSteps)) % AngleSteps)
+}
+
+func DequantizeAngle(q byte) float64 {
+	return float64(q) * 2 * math.Pi / AngleSteps
+}
+
+// QuantizeRatio maps v/max into 0..255, clamping values above max.
+func QuantizeRatio(v, max uint32) byte {
+	if max == 0 || v >= max {
+		if v == 0 {
+			return 0
+		}
+		return RatioSteps
+	}
+	return byte(math.Round(float64(v) / float64(max) * RatioSteps))
+}
+
+func DequantizeRatio(q byte, max uint32) uint32 {
+	return uint32(math.Round(float64(q) / RatioSteps * float64(max)))
+}
+
+func compactFlags(s EntityState) byte {
+	flags := s.Team & flagTeamMask
+	if s.Kind == KindProjectile {
+		flags |= flagProjectile
+	}
+	return flags | s.Status<<flagStatusBits
+}
+
+// quantize rounds s to exactly what the compact encoding can carry,
+// so deltas only fire when the encoded bytes change.
+func quantize(s EntityState) EntityState {
+	q := EntityState{
+		Kind:   s.Kind,
+		ID:     s.ID,
+		TypeID: s.TypeID,
+		Team:   s.Team & flagTeamMask,
+		Status: s.Status & (0xff >> flagStatusBits),
+		X:      DequantizePosition(QuantizePosition(s.X)),
+		Y:      DequantizePosition(QuantizePosition(s.Y)),
+	}
+	if s.Kind == KindProjectile {
+		q.Angle = DequantizeAngle(QuantizeAngle(s.Angle))
+	} else {
+		q.Health = uint32(QuantizeRatio(s.Health, s.MaxHealth))
+		q.Poison = uint32(QuantizeRatio(s.Poison, s.MaxHealth))
+	}
+	return q
+}
+
+func quantizeAll(list []EntityState) []EntityState {
+	out := make([]EntityState, len(list))
+	for i, s := range list {
+		out[i] = quantize(s)
+	}
+	return out
+}
+
+// compact : unit 11 byte, projectile 10 byte
+//
+//	[id u16][type u16][flags][x i16][y i16] + unit [health][poison] / projectile [angle]
+func appendEntityCompact(data []byte, s EntityState) []byte {
+	data = appendUint16(data, s.ID)
+	data = appendUint16(data, s.TypeID)
+	data = append(data, compactFlags(s))
+	data = appendUint16(data, uint16(QuantizePosition(s.X)))
+	data = appendUint16(data, uint16(QuantizePosition(s.Y)))
+	if s.Kind == KindProjectile {
+		return append(data, QuantizeAngle(s.Angle))
+	}
+	return append(data, QuantizeRatio(s.Health, s.MaxHealth), QuantizeRatio(s.Poison, s.MaxHealth))
+}
+
+// compactMask folds team and status into the shared flags byte and drops max health.
+func compactMask(mask uint16) uint16 {
+	if mask&(FieldSpawn|FieldTeam|FieldStatus) != 0 {
+		mask |= FieldTeam | FieldStatus
+	}
+	return mask &^ FieldMaxHealth
+}
+
+// appendFieldsCompact expects s to be quantized already.
+func appendFieldsCompact(data []byte, mask uint16, s EntityState) []byte {
+	if mask&FieldSpawn != 0 {
+		data = appendUint16(data, s.TypeID)
+	}
+	if mask&(FieldTeam|FieldStatus) != 0 {
+		data = append(data, compactFlags(s))
+	}
+	if mask&FieldX != 0 {
+		data = appendUint16(data, uint16(QuantizePosition(s.X)))
+	}
+	if mask&FieldY != 0 {
+		data = appendUint16(data, uint16(QuantizePosition(s.Y)))
+	}
+	if mask&FieldAngle != 0 {
+		data = append(data, QuantizeAngle(s.Angle))
+	}
+	if mask&FieldHealth != 0 {
+		data = append(data, byte(s.Health))
+	}
+	if mask&FieldPoison != 0 {
+		data = append(data, byte(s.Poison))
+	}
+	return data
+}
@@ -0,0 +1,207 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// 부동소수점 오차
+const epsilon = 1e-9
+
+func TestPositionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		v    float64
+	}{
+		{"zero", 0},
+		{"exact step", 3.0 / PositionScale},
+		{"half step", 0.5 / PositionScale},
+		{"just under half step", 0.49 / PositionScale},
+		{"field edge", 80},
+		{"negative field edge", -80},
+		{"fraction", 12.3456789},
+		{"negative fraction", -47.00123},
+		{"earth", -3},
+		{"largest", 127.99},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DequantizePosition(QuantizePosition(tt.v))
+			if err := math.Abs(got - tt.v); err > MaxPositionError+epsilon {
+				t.Errorf("%v -> %v, error %v > %v", tt.v, got, err, MaxPositionError)
+			}
+		})
+	}
+}
+
+func TestPositionClamps(t *testing.T) {
+	tests := []struct {
+		v    float64
+		want int16
+	}{
+		{1000, math.MaxInt16},
+		{-1000, math.MinInt16},
+		{math.Inf(1), math.MaxInt16},
+		{math.Inf(-1), math.MinInt16},
+	}
+	for _, tt := range tests {
+		if got := QuantizePosition(tt.v); got != tt.want {
+			t.Errorf("QuantizePosition(%v) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+}
+
+// angleDistance is the shortest way around the circle between a and b.
+func angleDistance(a, b float64) float64 {
+	d := math.Mod(math.Abs(a-b), 2*math.Pi)
+	return math.Min(d, 2*math.Pi-d)
+}
+
+func TestAngleRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		a    float64
+	}{
+		{"zero", 0},
+		{"quarter", math.Pi / 2},
+		{"half", math.Pi},
+		{"negative", -math.Pi / 3},
+		{"almost full turn", 2*math.Pi - 0.001},
+		{"more than a turn", 5 * math.Pi},
+		{"half step", math.Pi / AngleSteps},
+		{"odd", 1.2345},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DequantizeAngle(QuantizeAngle(tt.a))
+			if err := angleDistance(got, tt.a); err > MaxAngleError+epsilon {
+				t.Errorf("%v -> %v, error %v > %v", tt.a, got, err, MaxAngleError)
+			}
+		})
+	}
+}
+
+func TestRatioRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		v, max uint32
+		want   byte
+	}{
+		{"empty", 0, 5000, 0},
+		{"full", 5000, 5000, RatioSteps},
+		{"over max", 6000, 5000, RatioSteps},
+		{"half", 2500, 5000, 128},
+		{"zero max", 10, 0, RatioSteps},
+		{"zero of zero", 0, 0, 0},
+		{"one hp", 1, 5000, 0},
+		{"small max", 1, 3, 85},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := QuantizeRatio(tt.v, tt.max)
+			if q != tt.want {
+				t.Errorf("QuantizeRatio(%d, %d) = %d, want %d", tt.v, tt.max, q, tt.want)
+			}
+			if tt.v > tt.max || tt.max == 0 {
+				return
+			}
+			// 한 칸의 절반까지 틀릴 수 있다
+			got := DequantizeRatio(q, tt.max)
+			bound := float64(tt.max)/RatioSteps/2 + 1
+			if err := math.Abs(float64(got) - float64(tt.v)); err > bound {
+				t.Errorf("%d/%d -> %d, error %v > %v", tt.v, tt.max, got, err, bound)
+			}
+		})
+	}
+}
+
+// decodeCompact reads one record written by appendEntityCompact the way a client does.
+func decodeCompact(t *testing.T, b []byte, maxHealth uint32) EntityState {
+	t.Helper()
+	if len(b) < 9 {
+		t.Fatalf("record of %d bytes", len(b))
+	}
+	s := EntityState{
+		ID:     binary.BigEndian.Uint16(b[0:2]),
+		TypeID: binary.BigEndian.Uint16(b[2:4]),
+		Team:   b[4] & flagTeamMask,
+		Status: b[4] >> flagStatusBits,
+		X:      DequantizePosition(int16(binary.BigEndian.Uint16(b[5:7]))),
+		Y:      DequantizePosition(int16(binary.BigEndian.Uint16(b[7:9]))),
+	}
+	if b[4]&flagProjectile != 0 {
+		s.Kind = KindProjectile
+		if len(b) != 10 {
+			t.Fatalf("projectile record of %d bytes, want 10", len(b))
+		}
+		s.Angle = DequantizeAngle(b[9])
+		return s
+	}
+	if len(b) != 11 {
+		t.Fatalf("unit record of %d bytes, want 11", len(b))
+	}
+	s.MaxHealth = maxHealth
+	s.Health = DequantizeRatio(b[9], maxHealth)
+	s.Poison = DequantizeRatio(b[10], maxHealth)
+	return s
+}
+
+func TestCompactEntityRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		s    EntityState
+	}{
+		{"earth", EntityState{Kind: KindUnit, ID: 0, TypeID: 5, Team: 0, X: -3, Y: 0, Health: 5000, MaxHealth: 5000}},
+		{"hurt unit", EntityState{Kind: KindUnit, ID: 17, TypeID: 4, Team: 1, X: 31.337, Y: 0, Health: 613, MaxHealth: 1000, Poison: 120, Status: 3}},
+		{"dead unit", EntityState{Kind: KindUnit, ID: 0xffff, TypeID: 9, Team: 2, X: -79.99, Y: 1.5, Health: 0, MaxHealth: 400}},
+		{"falling eraser", EntityState{Kind: KindProjectile, ID: 42, TypeID: 11, Team: 2, X: 12.25, Y: 4.9, Angle: -math.Pi / 2}},
+		{"bullet", EntityState{Kind: KindProjectile, ID: 300, TypeID: 12, Team: 0, X: -50.001, Y: 1.17, Angle: 0.3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeCompact(t, AppendEntity(nil, EncodingCompact, tt.s), tt.s.MaxHealth)
+			want := tt.s
+			if got.Kind != want.Kind || got.ID != want.ID || got.TypeID != want.TypeID ||
+				got.Team != want.Team || got.Status != want.Status {
+				t.Fatalf("header = %+v, want %+v", got, want)
+			}
+			if err := math.Abs(got.X - want.X); err > MaxPositionError+epsilon {
+				t.Errorf("x error %v > %v", err, MaxPositionError)
+			}
+			if err := math.Abs(got.Y - want.Y); err > MaxPositionError+epsilon {
+				t.Errorf("y error %v > %v", err, MaxPositionError)
+			}
+			if want.Kind == KindProjectile {
+				if err := angleDistance(got.Angle, want.Angle); err > MaxAngleError+epsilon {
+					t.Errorf("angle error %v > %v", err, MaxAngleError)
+				}
+				return
+			}
+			bound := float64(want.MaxHealth)/RatioSteps/2 + 1
+			if err := math.Abs(float64(got.Health) - float64(want.Health)); err > bound {
+				t.Errorf("health %d, want %d", got.Health, want.Health)
+			}
+			if err := math.Abs(float64(got.Poison) - float64(want.Poison)); err > bound {
+				t.Errorf("poison %d, want %d", got.Poison, want.Poison)
+			}
+		})
+	}
+}
+
+// 양자화한 값을 다시 양자화해도 바뀌지 않아야 델타가 매 프레임 생기지 않는다
+func TestQuantizeIsStable(t *testing.T) {
+	states := []EntityState{
+		{Kind: KindUnit, ID: 1, TypeID: 4, Team: 1, X: 31.337, Y: 0.001, Health: 613, MaxHealth: 1000, Poison: 7},
+		{Kind: KindProjectile, ID: 2, TypeID: 12, Team: 0, X: -50.001, Y: 1.17, Angle: 2.5},
+	}
+	for _, s := range states {
+		q := quantize(s)
+		q.MaxHealth = s.MaxHealth
+		again := quantize(q)
+		again.MaxHealth = s.MaxHealth
+		if q.X != again.X || q.Y != again.Y || q.Angle != again.Angle {
+			t.Errorf("quantize not stable: %+v then %+v", q, again)
+		}
+	}
+}
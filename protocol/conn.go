@@ -0,0 +1,70 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"net"
+	"sync"
+	"time"
+)
+
+var ErrFrameOverflow = errors.New("frame larger than 65535 bytes")
+
+// Conn carries whole frames, regardless of the transport underneath.
+type Conn interface {
+	ReadFrame() ([]byte, error)
+	WriteFrame([]byte) error
+	Close() error
+	RemoteAddr() net.Addr
+	SetReadDeadline(time.Time) error
+	SetWriteDeadline(time.Time) error
+}
+
+// StreamConn frames a byte stream (TCP) with a 2 byte big-endian size prefix.
+type StreamConn struct {
+	conn   net.Conn
+	reader *FrameReader
+	mutex  sync.Mutex
+}
+
+func NewStreamConn(c net.Conn) *StreamConn {
+	return &StreamConn{
+		conn:   c,
+		reader: NewFrameReader(c, MaxFrameSize),
+	}
+}
+
+func (c *StreamConn) ReadFrame() ([]byte, error) {
+	return c.reader.Next()
+}
+
+func (c *StreamConn) WriteFrame(data []byte) error {
+	if len(data) > math.MaxUint16 {
+		return ErrFrameOverflow
+	}
+	buf := make([]byte, 2+len(data))
+	binary.BigEndian.PutUint16(buf, uint16(len(data)))
+	copy(buf[2:], data)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+func (c *StreamConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *StreamConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *StreamConn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+func (c *StreamConn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
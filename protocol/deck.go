@@ -0,0 +1,82 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	MaxDeckNameLength = 20
+	MaxDecks          = 10 // 플레이어마다 저장할 수 있는 덱
+)
+
+// SavedDeck is a deck kept on the server under a player's name.
+type SavedDeck struct {
+	ID    uint32
+	Name  string
+	Cards [DeckSize]uint32
+}
+
+// DeckSave stores Cards under Name; ID 0 makes a new deck, otherwise it overwrites.
+type DeckSave struct {
+	SavedDeck
+}
+
+type DecksRequest struct{}
+
+// DeckSelect loads a saved deck as the deck for the next match.
+type DeckSelect struct {
+	ID uint32
+}
+
+func (DeckSave) Type() byte     { return TypeDeckSave }
+func (DecksRequest) Type() byte { return TypeDecks }
+func (DeckSelect) Type() byte   { return TypeDeckSelect }
+
+// [id u32][name][card u32 x8]
+func DecodeDeckSave(b []byte) (DeckSave, error) {
+	var m DeckSave
+	if len(b) < 4 {
+		return m, fmt.Errorf("deck save: %w", ErrShortPacket)
+	}
+	m.ID = binary.BigEndian.Uint32(b[0:4])
+	var err error
+	if m.Name, b, err = readString(b[4:], MaxDeckNameLength); err != nil {
+		return m, fmt.Errorf("deck save: name: %w", err)
+	}
+	if len(m.Name) == 0 {
+		return m, fmt.Errorf("deck save: empty name: %w", ErrInvalidValue)
+	}
+	if len(b) != 4*DeckSize {
+		return m, fmt.Errorf("deck save: %d card bytes: %w", len(b), ErrInvalidValue)
+	}
+	for i := range m.Cards {
+		m.Cards[i] = binary.BigEndian.Uint32(b[4*i:])
+	}
+	return m, nil
+}
+
+func DecodeDeckSelect(b []byte) (DeckSelect, error) {
+	if len(b) < 4 {
+		return DeckSelect{}, fmt.Errorf("deck select: %w", ErrShortPacket)
+	}
+	return DeckSelect{ID: binary.BigEndian.Uint32(b[0:4])}, nil
+}
+
+// DeckList answers DecksRequest and DeckSave with every deck the player saved.
+type DeckList struct {
+	Decks []SavedDeck
+}
+
+// [count]{[id u32][name][card u32 x8]}
+func (l DeckList) Encode() []byte {
+	data := []byte{OutDeckList, byte(len(l.Decks))}
+	for _, d := range l.Decks {
+		data = appendUint32(data, d.ID)
+		data = appendString(data, d.Name)
+		for _, id := range d.Cards {
+			data = appendUint32(data, id)
+		}
+	}
+	return data
+}
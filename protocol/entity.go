@@ -0,0 +1,80 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+type EntityKind byte
+
+const (
+	KindUnit EntityKind = iota
+	KindProjectile
+)
+
+// EntityState is the network view of a unit or projectile, independent of encoding.
+type EntityState struct {
+	Kind   EntityKind
+	ID     uint16
+	TypeID uint16
+	Team   byte
+
+	X     float64
+	Y     float64
+	Angle float64
+
+	Health    uint32
+	MaxHealth uint32
+	Poison    uint32
+	Status    byte
+}
+
+type Encoding byte
+
+const (
+	EncodingV1 Encoding = iota
+	EncodingCompact
+)
+
+// SelectEncoding picks the entity encoding from the negotiated capabilities.
+// Every supported version understands both encodings, so only caps matter.
+func SelectEncoding(caps Capabilities) Encoding {
+	if caps.Has(CapCompactEntities) {
+		return EncodingCompact
+	}
+	return EncodingV1
+}
+
+func AppendEntity(data []byte, enc Encoding, s EntityState) []byte {
+	switch enc {
+	case EncodingCompact:
+		return appendEntityCompact(data, s)
+	default:
+		return appendEntityV1(data, s)
+	}
+}
+
+// v1 : unit 34 byte, projectile 29 byte, float64 위치
+func appendEntityV1(data []byte, s EntityState) []byte {
+	if s.Kind == KindProjectile {
+		var b [29]byte
+		binary.BigEndian.PutUint16(b[0:2], s.ID)
+		binary.BigEndian.PutUint16(b[2:4], s.TypeID)
+		b[4] = s.Team + TeamCount
+		binary.BigEndian.PutUint64(b[5:13], math.Float64bits(s.X))
+		binary.BigEndian.PutUint64(b[13:21], math.Float64bits(s.Y))
+		binary.BigEndian.PutUint64(b[21:29], math.Float64bits(s.Angle))
+		return append(data, b[:]...)
+	}
+	var b [34]byte
+	binary.BigEndian.PutUint16(b[0:2], s.ID)
+	binary.BigEndian.PutUint16(b[2:4], s.TypeID)
+	b[4] = s.Team
+	binary.BigEndian.PutUint64(b[5:13], math.Float64bits(s.X))
+	binary.BigEndian.PutUint64(b[13:21], math.Float64bits(s.Y))
+	binary.BigEndian.PutUint32(b[21:25], s.Health)
+	binary.BigEndian.PutUint32(b[25:29], s.MaxHealth)
+	binary.BigEndian.PutUint32(b[29:33], s.Poison)
+	b[33] = s.Status
+	return append(data, b[:]...)
+}
@@ -0,0 +1,40 @@
+package protocol
+
+import (
+	"encoding/binary"
+)
+
+type ErrorCode uint16
+
+const (
+	ErrCodeUnknown ErrorCode = iota
+	ErrCodeRoomFull
+	ErrCodeNotAllowed
+	ErrCodeNotFound
+	ErrCodeWrongPassword
+	ErrCodeBadRequest
+	ErrCodeTeamTaken
+	ErrCodeNoTeam
+	ErrCodeNotHost
+	ErrCodeNeedPlayers
+	ErrCodeInGame
+	ErrCodeQueued
+	ErrCodeBadDeck
+)
+
+// Error is sent to a single client when a request of theirs was refused.
+type Error struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (e Error) Error() string {
+	return e.Message
+}
+
+func (e Error) Encode() []byte {
+	data := make([]byte, 3, 3+len(e.Message))
+	data[0] = OutError
+	binary.BigEndian.PutUint16(data[1:3], uint16(e.Code))
+	return append(data, e.Message...)
+}
@@ -0,0 +1,51 @@
+package protocol
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// 클라이언트로 보내는 패킷과 같은 형식 : [2byte big-endian size][data]
+const MaxFrameSize = 4096
+
+var ErrFrameTooLarge = errors.New("frame size exceeds limit")
+
+type FrameReader struct {
+	r   *bufio.Reader
+	max int
+}
+
+func NewFrameReader(r io.Reader, max int) *FrameReader {
+	return &FrameReader{
+		r:   bufio.NewReaderSize(r, max+2),
+		max: max,
+	}
+}
+
+// Next blocks until a whole frame is buffered and returns its payload.
+func (f *FrameReader) Next() ([]byte, error) {
+	header, err := f.r.Peek(2)
+	if err != nil {
+		if err == io.EOF && len(header) > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	size := int(binary.BigEndian.Uint16(header))
+	if size > f.max {
+		return nil, ErrFrameTooLarge
+	}
+	if _, err := f.r.Discard(2); err != nil {
+		return nil, err
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(f.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,130 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Version 은 Unit / Projectile 레코드 형식이 바뀔 때마다 올린다.
+const (
+	MinVersion     uint16 = 1
+	CurrentVersion uint16 = 1
+)
+
+type Capabilities uint32
+
+const (
+	CapDeltaSnapshot   Capabilities = 1 << iota // OutWorld 대신 OutSnapshot 델타를 받는다
+	CapCompactEntities                          // 위치, 각도, 체력을 양자화한 EncodingCompact
+)
+
+// capabilities this server can offer to a client
+const ServerCapabilities = CapDeltaSnapshot | CapCompactEntities
+
+func (c Capabilities) Has(flag Capabilities) bool {
+	return c&flag == flag
+}
+
+const SessionSize = 16
+
+type SessionToken [SessionSize]byte
+
+func (t SessionToken) IsZero() bool {
+	return t == SessionToken{}
+}
+
+const (
+	RolePlayer byte = iota
+	RoleSpectator
+)
+
+type Hello struct {
+	Version      uint16
+	Capabilities Capabilities
+	Session      SessionToken // 재접속할 때만, 아니면 0
+	Role         byte
+}
+
+func (Hello) Type() byte { return TypeHello }
+
+// [version u16][capabilities u32]([session 16byte]([role]))
+func DecodeHello(b []byte) (Hello, error) {
+	if len(b) < 6 {
+		return Hello{}, fmt.Errorf("hello: %w", ErrShortPacket)
+	}
+	h := Hello{
+		Version:      binary.BigEndian.Uint16(b[0:2]),
+		Capabilities: Capabilities(binary.BigEndian.Uint32(b[2:6])),
+	}
+	b = b[6:]
+	if len(b) > 0 {
+		if len(b) < SessionSize {
+			return Hello{}, fmt.Errorf("hello: session: %w", ErrShortPacket)
+		}
+		copy(h.Session[:], b)
+		b = b[SessionSize:]
+	}
+	if len(b) > 0 {
+		if b[0] > RoleSpectator {
+			return Hello{}, fmt.Errorf("hello: role %d: %w", b[0], ErrInvalidValue)
+		}
+		h.Role = b[0]
+	}
+	return h, nil
+}
+
+type HelloReply struct {
+	Accepted     bool
+	Version      uint16
+	Capabilities Capabilities
+	Reason       string
+}
+
+func (r HelloReply) Encode() []byte {
+	data := make([]byte, 8, 8+len(r.Reason))
+	data[0] = OutHelloReply
+	if r.Accepted {
+		data[1] = 1
+	}
+	binary.BigEndian.PutUint16(data[2:4], r.Version)
+	binary.BigEndian.PutUint32(data[4:8], uint32(r.Capabilities))
+	return append(data, r.Reason...)
+}
+
+// Negotiate picks the highest version both sides speak and the shared capabilities.
+func Negotiate(h Hello) HelloReply {
+	if h.Version < MinVersion {
+		return HelloReply{
+			Version: CurrentVersion,
+			Reason:  fmt.Sprintf("protocol version %d is no longer supported (minimum %d)", h.Version, MinVersion),
+		}
+	}
+	version := h.Version
+	if version > CurrentVersion {
+		version = CurrentVersion
+	}
+	return HelloReply{
+		Accepted:     true,
+		Version:      version,
+		Capabilities: h.Capabilities & ServerCapabilities,
+	}
+}
+
+// Session is sent once a player owns a slot; presenting the token in a later Hello
+// re-attaches the connection to that slot.
+type Session struct {
+	Token   SessionToken
+	Grace   uint16 // seconds
+	Resumed bool
+}
+
+func (s Session) Encode() []byte {
+	data := make([]byte, 2+SessionSize+2)
+	data[0] = OutSession
+	if s.Resumed {
+		data[1] = 1
+	}
+	copy(data[2:], s.Token[:])
+	binary.BigEndian.PutUint16(data[2+SessionSize:], s.Grace)
+	return data
+}
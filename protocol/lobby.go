@@ -0,0 +1,173 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	MaxRoomNameLength = 32
+	MaxPasswordLength = 32
+	RoomCodeLength    = 6
+)
+
+type ListRooms struct{}
+
+// CreateRoom 의 Private 방은 목록에 나오지 않고 코드로만 들어올 수 있다
+type CreateRoom struct {
+	Name     string
+	Password string
+	Private  bool
+}
+
+// JoinRoom with ID 0 joins any waiting room.
+type JoinRoom struct {
+	ID       uint64
+	Password string
+}
+
+type JoinCode struct {
+	Code string
+}
+
+func (ListRooms) Type() byte  { return TypeListRooms }
+func (CreateRoom) Type() byte { return TypeCreateRoom }
+func (JoinRoom) Type() byte   { return TypeJoinRoom }
+func (JoinCode) Type() byte   { return TypeJoinCode }
+
+// 문자열은 [len byte][bytes]
+func readString(b []byte, max int) (string, []byte, error) {
+	if len(b) < 1 {
+		return "", nil, ErrShortPacket
+	}
+	n := int(b[0])
+	if n > max {
+		return "", nil, ErrInvalidValue
+	}
+	if len(b) < 1+n {
+		return "", nil, ErrShortPacket
+	}
+	return string(b[1 : 1+n]), b[1+n:], nil
+}
+
+func appendString(data []byte, s string) []byte {
+	if len(s) > 255 {
+		s = s[:255]
+	}
+	data = append(data, byte(len(s)))
+	return append(data, s...)
+}
+
+// [name][password][private]
+func DecodeCreateRoom(b []byte) (CreateRoom, error) {
+	var m CreateRoom
+	var err error
+	if m.Name, b, err = readString(b, MaxRoomNameLength); err != nil {
+		return CreateRoom{}, fmt.Errorf("create room: name: %w", err)
+	}
+	if m.Password, b, err = readString(b, MaxPasswordLength); err != nil {
+		return CreateRoom{}, fmt.Errorf("create room: password: %w", err)
+	}
+	if len(b) > 0 {
+		m.Private = b[0] != 0
+	}
+	return m, nil
+}
+
+// [id u64][password]
+func DecodeJoinRoom(b []byte) (JoinRoom, error) {
+	if len(b) < 8 {
+		return JoinRoom{}, fmt.Errorf("join room: %w", ErrShortPacket)
+	}
+	m := JoinRoom{ID: binary.BigEndian.Uint64(b[0:8])}
+	if len(b) > 8 {
+		var err error
+		if m.Password, _, err = readString(b[8:], MaxPasswordLength); err != nil {
+			return JoinRoom{}, fmt.Errorf("join room: password: %w", err)
+		}
+	}
+	return m, nil
+}
+
+func DecodeJoinCode(b []byte) (JoinCode, error) {
+	code := trimString(b)
+	if len(code) != RoomCodeLength {
+		return JoinCode{}, fmt.Errorf("join code: length %d: %w", len(code), ErrInvalidValue)
+	}
+	return JoinCode{Code: string(code)}, nil
+}
+
+type RoomEntry struct {
+	ID         uint64
+	Name       string
+	Players    byte
+	Spectators byte
+	Status     byte
+	Teams      byte // bit n : team n 을 누군가 골랐다
+	Locked     bool // 비밀번호 필요
+}
+
+type RoomList struct {
+	Rooms []RoomEntry
+}
+
+// [count u16]{[id u64][name][players][spectators][status][teams][locked]}
+func (l RoomList) Encode() []byte {
+	data := []byte{OutRoomList}
+	data = appendUint16(data, uint16(len(l.Rooms)))
+	for _, r := range l.Rooms {
+		var id [8]byte
+		binary.BigEndian.PutUint64(id[:], r.ID)
+		data = append(data, id[:]...)
+		data = appendString(data, r.Name)
+		locked := byte(0)
+		if r.Locked {
+			locked = 1
+		}
+		data = append(data, r.Players, r.Spectators, r.Status, r.Teams, locked)
+	}
+	return data
+}
+
+type RoomJoined struct {
+	ID   uint64
+	Code string // 비공개 방일 때만
+}
+
+func (r RoomJoined) Encode() []byte {
+	data := make([]byte, 9, 10+len(r.Code))
+	data[0] = OutRoomJoined
+	binary.BigEndian.PutUint64(data[1:9], r.ID)
+	return appendString(data, r.Code)
+}
+
+type ReadyState struct {
+	ID    uint16
+	Team  byte
+	Ready bool
+}
+
+// RoomState is broadcast in the waiting room whenever a team, ready flag or the countdown changes.
+type RoomState struct {
+	Status    byte
+	Host      uint16 // player id
+	Countdown uint16 // 남은 프레임, 카운트다운 중이 아니면 0
+	Players   []ReadyState
+}
+
+// [status][host u16][countdown u16][count]{[id u16][team][ready]}
+func (s RoomState) Encode() []byte {
+	data := []byte{OutRoomState, s.Status}
+	data = appendUint16(data, s.Host)
+	data = appendUint16(data, s.Countdown)
+	data = append(data, byte(len(s.Players)))
+	for _, p := range s.Players {
+		ready := byte(0)
+		if p.Ready {
+			ready = 1
+		}
+		data = appendUint16(data, p.ID)
+		data = append(data, p.Team, ready)
+	}
+	return data
+}
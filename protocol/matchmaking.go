@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Queue puts the client in the matchmaking queue. Role is the team it would like to play,
+// or NoTeam for any.
+type Queue struct {
+	Role byte
+	Name string
+}
+
+type CancelQueue struct{}
+
+func (Queue) Type() byte       { return TypeQueue }
+func (CancelQueue) Type() byte { return TypeCancelQueue }
+
+// [role][name]
+func DecodeQueue(b []byte) (Queue, error) {
+	if len(b) < 1 {
+		return Queue{}, fmt.Errorf("queue: %w", ErrShortPacket)
+	}
+	m := Queue{Role: b[0]}
+	if m.Role >= TeamCount && m.Role != NoTeam {
+		return Queue{}, fmt.Errorf("queue: role %d: %w", m.Role, ErrInvalidValue)
+	}
+	var err error
+	if m.Name, _, err = readString(b[1:], MaxNameLength); err != nil {
+		return Queue{}, fmt.Errorf("queue: name: %w", err)
+	}
+	if m.Name == "" {
+		return Queue{}, fmt.Errorf("queue: name: %w", ErrInvalidValue)
+	}
+	return m, nil
+}
+
+const (
+	QueueWaiting byte = iota
+	QueueMatched
+	QueueTimeout
+	QueueCancelled
+)
+
+// QueueStatus is sent while waiting, and once more when the wait ends.
+// A matched client joins Room with JoinRoom and gets Team.
+type QueueStatus struct {
+	State  byte
+	Rating uint16
+	Window uint16 // 지금 허용하는 레이팅 차이
+	Waited uint16 // 초
+	Room   uint64
+	Team   byte
+}
+
+// [state][rating u16][window u16][waited u16][room u64][team]
+func (s QueueStatus) Encode() []byte {
+	data := []byte{OutQueueStatus, s.State}
+	data = appendUint16(data, s.Rating)
+	data = appendUint16(data, s.Window)
+	data = appendUint16(data, s.Waited)
+	var room [8]byte
+	binary.BigEndian.PutUint64(room[:], s.Room)
+	data = append(data, room[:]...)
+	return append(data, s.Team)
+}
@@ -0,0 +1,249 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// client -> server message types
+const (
+	TypeJoin byte = iota
+	TypeChangeTeam
+	TypeChat
+	TypeUseCard
+	TypeDeckSet
+	TypePong
+	TypeHello
+	TypeAck
+	TypeListRooms
+	TypeCreateRoom
+	TypeJoinRoom
+	TypeJoinCode
+	TypeReady
+	TypeStartGame
+	TypeQueue
+	TypeCancelQueue
+	TypeRatings
+	TypeReplayControl
+	TypeCards
+	TypeDeckSave
+	TypeDecks
+	TypeDeckSelect
+	TypeCollection
+	TypeLogin
+)
+
+// server -> client message types
+const (
+	OutPlayers byte = iota
+	OutStart
+	OutChat
+	OutPlayerState
+	OutWorld
+	OutPing
+	OutRemove
+	OutEnd
+	OutSpawn
+	OutHelloReply
+	OutSnapshot
+	OutSession
+	OutError
+	OutRoomList
+	OutRoomJoined
+	OutRoomState
+	OutQueueStatus
+	OutRatings
+	OutReplayState
+	OutCardList
+	OutDeckList
+	OutCollection
+	OutLoggedIn
+)
+
+const (
+	MaxNameLength = 20
+	HandSize      = 4
+	DeckSize      = 8
+	TeamCount     = 3
+	MaxCardX      = 100
+
+	NoTeam byte = 0xff // 아직 팀을 고르지 않음
+)
+
+var (
+	ErrEmptyPacket  = errors.New("empty packet")
+	ErrShortPacket  = errors.New("packet too short")
+	ErrUnknownType  = errors.New("unknown message type")
+	ErrInvalidValue = errors.New("invalid value")
+)
+
+type Message interface {
+	Type() byte
+}
+
+type JoinRequest struct {
+	Name string
+}
+
+type ChangeTeam struct {
+	Team byte
+}
+
+type Chat struct {
+	Message string
+}
+
+type UseCard struct {
+	Slot uint8
+	X    int16
+}
+
+type DeckSet struct {
+	Cards []uint32
+}
+
+type Pong struct{}
+
+type Ready struct {
+	Ready bool
+}
+
+type StartGame struct{}
+
+func (JoinRequest) Type() byte { return TypeJoin }
+func (ChangeTeam) Type() byte  { return TypeChangeTeam }
+func (Chat) Type() byte        { return TypeChat }
+func (UseCard) Type() byte     { return TypeUseCard }
+func (DeckSet) Type() byte     { return TypeDeckSet }
+func (Pong) Type() byte        { return TypePong }
+func (Ready) Type() byte       { return TypeReady }
+func (StartGame) Type() byte   { return TypeStartGame }
+
+// Decode parses one frame payload received from a client.
+func Decode(data []byte) (Message, error) {
+	if len(data) == 0 {
+		return nil, ErrEmptyPacket
+	}
+	body := data[1:]
+	switch data[0] {
+	case TypeJoin:
+		return DecodeJoinRequest(body)
+	case TypeChangeTeam:
+		return DecodeChangeTeam(body)
+	case TypeChat:
+		return DecodeChat(body)
+	case TypeUseCard:
+		return DecodeUseCard(body)
+	case TypeDeckSet:
+		return DecodeDeckSet(body)
+	case TypePong:
+		return Pong{}, nil
+	case TypeHello:
+		return DecodeHello(body)
+	case TypeAck:
+		return DecodeAck(body)
+	case TypeListRooms:
+		return ListRooms{}, nil
+	case TypeCreateRoom:
+		return DecodeCreateRoom(body)
+	case TypeJoinRoom:
+		return DecodeJoinRoom(body)
+	case TypeJoinCode:
+		return DecodeJoinCode(body)
+	case TypeReady:
+		if len(body) < 1 {
+			return nil, fmt.Errorf("ready: %w", ErrShortPacket)
+		}
+		return Ready{Ready: body[0] != 0}, nil
+	case TypeStartGame:
+		return StartGame{}, nil
+	case TypeQueue:
+		return DecodeQueue(body)
+	case TypeCancelQueue:
+		return CancelQueue{}, nil
+	case TypeRatings:
+		return DecodeRatingsRequest(body)
+	case TypeReplayControl:
+		return DecodeReplayControl(body)
+	case TypeCards:
+		return CardsRequest{}, nil
+	case TypeDeckSave:
+		return DecodeDeckSave(body)
+	case TypeDecks:
+		return DecksRequest{}, nil
+	case TypeDeckSelect:
+		return DecodeDeckSelect(body)
+	case TypeCollection:
+		return CollectionRequest{}, nil
+	case TypeLogin:
+		return DecodeLogin(body)
+	}
+	return nil, fmt.Errorf("%w: %d", ErrUnknownType, data[0])
+}
+
+func DecodeJoinRequest(b []byte) (JoinRequest, error) {
+	name := trimString(b)
+	if len(name) == 0 {
+		return JoinRequest{}, fmt.Errorf("join: %w", ErrShortPacket)
+	}
+	if len(name) > MaxNameLength {
+		return JoinRequest{}, fmt.Errorf("join: name longer than %d bytes: %w", MaxNameLength, ErrInvalidValue)
+	}
+	return JoinRequest{Name: string(name)}, nil
+}
+
+func DecodeChangeTeam(b []byte) (ChangeTeam, error) {
+	if len(b) < 1 {
+		return ChangeTeam{}, fmt.Errorf("change team: %w", ErrShortPacket)
+	}
+	if b[0] >= TeamCount {
+		return ChangeTeam{}, fmt.Errorf("change team: team %d: %w", b[0], ErrInvalidValue)
+	}
+	return ChangeTeam{Team: b[0]}, nil
+}
+
+func DecodeChat(b []byte) (Chat, error) {
+	msg := trimString(b)
+	if len(msg) == 0 {
+		return Chat{}, fmt.Errorf("chat: %w", ErrShortPacket)
+	}
+	return Chat{Message: string(msg)}, nil
+}
+
+func DecodeUseCard(b []byte) (UseCard, error) {
+	if len(b) < 3 {
+		return UseCard{}, fmt.Errorf("use card: %w", ErrShortPacket)
+	}
+	m := UseCard{
+		Slot: b[0],
+		X:    int16(binary.BigEndian.Uint16(b[1:3])),
+	}
+	if m.Slot >= HandSize {
+		return UseCard{}, fmt.Errorf("use card: slot %d: %w", m.Slot, ErrInvalidValue)
+	}
+	if m.X > MaxCardX || m.X < -MaxCardX {
+		return UseCard{}, fmt.Errorf("use card: x %d: %w", m.X, ErrInvalidValue)
+	}
+	return m, nil
+}
+
+func DecodeDeckSet(b []byte) (DeckSet, error) {
+	if len(b)%4 != 0 {
+		return DeckSet{}, fmt.Errorf("deck set: length %d: %w", len(b), ErrShortPacket)
+	}
+	m := DeckSet{}
+	for ; len(b) > 0; b = b[4:] {
+		m.Cards = append(m.Cards, binary.BigEndian.Uint32(b[0:4]))
+	}
+	if len(m.Cards) > DeckSize {
+		return DeckSet{}, fmt.Errorf("deck set: %d cards: %w", len(m.Cards), ErrInvalidValue)
+	}
+	return m, nil
+}
+
+// 클라이언트가 문자열 끝에 붙여 보내는 빈 바이트 제거
+func trimString(b []byte) []byte {
+	return bytes.TrimRight(b, "\x00")
+}
@@ -0,0 +1,63 @@
+package protocol
+
+import (
+	"fmt"
+)
+
+// 최근 기록 몇 개까지 보내는지
+const RatingHistoryLength = 20
+
+type RatingsRequest struct {
+	Name string
+}
+
+func (RatingsRequest) Type() byte { return TypeRatings }
+
+func DecodeRatingsRequest(b []byte) (RatingsRequest, error) {
+	name := trimString(b)
+	if len(name) == 0 {
+		return RatingsRequest{}, fmt.Errorf("ratings: %w", ErrShortPacket)
+	}
+	if len(name) > MaxNameLength {
+		return RatingsRequest{}, fmt.Errorf("ratings: name: %w", ErrInvalidValue)
+	}
+	return RatingsRequest{Name: string(name)}, nil
+}
+
+type RatingEntry struct {
+	Role   byte
+	Before uint16
+	After  uint16
+	Won    bool
+	Time   uint32 // unix
+}
+
+// Ratings is a player's current rating per role and their latest changes, newest first.
+type Ratings struct {
+	Name    string
+	Overall uint16
+	Roles   [TeamCount]uint16
+	History []RatingEntry
+}
+
+// [name][overall u16][role u16 x3][count]{[role][before u16][after u16][won][time u32]}
+func (r Ratings) Encode() []byte {
+	data := appendString([]byte{OutRatings}, r.Name)
+	data = appendUint16(data, r.Overall)
+	for _, v := range r.Roles {
+		data = appendUint16(data, v)
+	}
+	data = append(data, byte(len(r.History)))
+	for _, e := range r.History {
+		won := byte(0)
+		if e.Won {
+			won = 1
+		}
+		data = append(data, e.Role)
+		data = appendUint16(data, e.Before)
+		data = appendUint16(data, e.After)
+		data = append(data, won)
+		data = appendUint32(data, e.Time)
+	}
+	return data
+}
@@ -0,0 +1,66 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// 재생 속도는 0.5 배 단위 : 1 = 0.5x, 2 = 1x, 16 = 8x
+const (
+	MinReplaySpeed = 1
+	MaxReplaySpeed = 16
+)
+
+const (
+	ReplayPause byte = iota
+	ReplayResume
+	ReplaySpeed // Value : 속도
+	ReplaySeek  // Value : 프레임
+)
+
+// ReplayControl is sent by a viewer of a replay room.
+type ReplayControl struct {
+	Op    byte
+	Value uint32
+}
+
+func (ReplayControl) Type() byte { return TypeReplayControl }
+
+// [op][value u32]
+func DecodeReplayControl(b []byte) (ReplayControl, error) {
+	if len(b) < 1 {
+		return ReplayControl{}, fmt.Errorf("replay control: %w", ErrShortPacket)
+	}
+	m := ReplayControl{Op: b[0]}
+	if m.Op > ReplaySeek {
+		return ReplayControl{}, fmt.Errorf("replay control: op %d: %w", m.Op, ErrInvalidValue)
+	}
+	if m.Op == ReplaySpeed || m.Op == ReplaySeek {
+		if len(b) < 5 {
+			return ReplayControl{}, fmt.Errorf("replay control: %w", ErrShortPacket)
+		}
+		m.Value = binary.BigEndian.Uint32(b[1:5])
+	}
+	if m.Op == ReplaySpeed && (m.Value < MinReplaySpeed || m.Value > MaxReplaySpeed) {
+		return ReplayControl{}, fmt.Errorf("replay control: speed %d: %w", m.Value, ErrInvalidValue)
+	}
+	return m, nil
+}
+
+type ReplayState struct {
+	Paused   bool
+	Speed    byte
+	Frame    uint32
+	Duration uint32
+}
+
+// [paused][speed][frame u32][duration u32]
+func (s ReplayState) Encode() []byte {
+	paused := byte(0)
+	if s.Paused {
+		paused = 1
+	}
+	data := []byte{OutReplayState, paused, s.Speed}
+	data = appendUint32(data, s.Frame)
+	return appendUint32(data, s.Duration)
+}
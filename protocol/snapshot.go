@@ -0,0 +1,210 @@
+package protocol
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// changed-field mask of one entity in a snapshot
+const (
+	FieldSpawn uint16 = 1 << iota // kind, type id
+	FieldTeam
+	FieldX
+	FieldY
+	FieldAngle
+	FieldHealth
+	FieldMaxHealth
+	FieldPoison
+	FieldStatus
+)
+
+const (
+	unitFields       = FieldSpawn | FieldTeam | FieldX | FieldY | FieldHealth | FieldMaxHealth | FieldPoison | FieldStatus
+	projectileFields = FieldSpawn | FieldTeam | FieldX | FieldY | FieldAngle
+)
+
+// Snapshot is the world state of one tick. Seq 0 is never used, so a base of 0 means keyframe.
+type Snapshot struct {
+	Seq      uint32
+	Entities []EntityState
+}
+
+type Ack struct {
+	Seq uint32
+}
+
+func (Ack) Type() byte { return TypeAck }
+
+func DecodeAck(b []byte) (Ack, error) {
+	if len(b) < 4 {
+		return Ack{}, fmt.Errorf("ack: %w", ErrShortPacket)
+	}
+	return Ack{Seq: binary.BigEndian.Uint32(b[0:4])}, nil
+}
+
+func fullMask(s EntityState) uint16 {
+	if s.Kind == KindProjectile {
+		return projectileFields
+	}
+	return unitFields
+}
+
+func diffMask(base, cur EntityState) uint16 {
+	if base.Kind != cur.Kind || base.TypeID != cur.TypeID {
+		return fullMask(cur)
+	}
+	var mask uint16
+	if base.Team != cur.Team {
+		mask |= FieldTeam
+	}
+	if base.X != cur.X {
+		mask |= FieldX
+	}
+	if base.Y != cur.Y {
+		mask |= FieldY
+	}
+	if base.Angle != cur.Angle {
+		mask |= FieldAngle
+	}
+	if base.Health != cur.Health {
+		mask |= FieldHealth
+	}
+	if base.MaxHealth != cur.MaxHealth {
+		mask |= FieldMaxHealth
+	}
+	if base.Poison != cur.Poison {
+		mask |= FieldPoison
+	}
+	if base.Status != cur.Status {
+		mask |= FieldStatus
+	}
+	return mask
+}
+
+// AppendSnapshot writes cur as a delta against base, or as a keyframe when base is nil.
+//
+//	[OutSnapshot][seq u32][base u32]
+//	[removed u16]{id u16}
+//	[changed u16]{id u16, mask u16, fields...}
+func AppendSnapshot(data []byte, enc Encoding, base *Snapshot, cur *Snapshot) []byte {
+	entities := cur.Entities
+	var baseEntities []EntityState
+	if base != nil {
+		baseEntities = base.Entities
+	}
+	if enc == EncodingCompact {
+		entities = quantizeAll(entities)
+		baseEntities = quantizeAll(baseEntities)
+	}
+
+	var baseSeq uint32
+	prev := map[uint16]EntityState{}
+	if base != nil {
+		baseSeq = base.Seq
+		for _, s := range baseEntities {
+			prev[s.ID] = s
+		}
+	}
+
+	var head [9]byte
+	head[0] = OutSnapshot
+	binary.BigEndian.PutUint32(head[1:5], cur.Seq)
+	binary.BigEndian.PutUint32(head[5:9], baseSeq)
+	data = append(data, head[:]...)
+
+	// removed
+	countAt := len(data)
+	data = append(data, 0, 0)
+	var removed uint16
+	if base != nil {
+		alive := make(map[uint16]bool, len(entities))
+		for _, s := range entities {
+			alive[s.ID] = true
+		}
+		for _, s := range baseEntities {
+			if !alive[s.ID] {
+				data = appendUint16(data, s.ID)
+				removed++
+			}
+		}
+	}
+	binary.BigEndian.PutUint16(data[countAt:], removed)
+
+	// spawned or changed
+	countAt = len(data)
+	data = append(data, 0, 0)
+	var changed uint16
+	for _, s := range entities {
+		mask := fullMask(s)
+		if p, ok := prev[s.ID]; ok {
+			mask = diffMask(p, s)
+		}
+		if enc == EncodingCompact {
+			mask = compactMask(mask)
+		}
+		if mask == 0 {
+			continue
+		}
+		data = appendUint16(data, s.ID)
+		data = appendUint16(data, mask)
+		data = appendFields(data, enc, mask, s)
+		changed++
+	}
+	binary.BigEndian.PutUint16(data[countAt:], changed)
+
+	return data
+}
+
+func appendFields(data []byte, enc Encoding, mask uint16, s EntityState) []byte {
+	if enc == EncodingCompact {
+		return appendFieldsCompact(data, mask, s)
+	}
+	if mask&FieldSpawn != 0 {
+		data = append(data, byte(s.Kind))
+		data = appendUint16(data, s.TypeID)
+	}
+	if mask&FieldTeam != 0 {
+		data = append(data, s.Team)
+	}
+	if mask&FieldX != 0 {
+		data = appendFloat64(data, s.X)
+	}
+	if mask&FieldY != 0 {
+		data = appendFloat64(data, s.Y)
+	}
+	if mask&FieldAngle != 0 {
+		data = appendFloat64(data, s.Angle)
+	}
+	if mask&FieldHealth != 0 {
+		data = appendUint32(data, s.Health)
+	}
+	if mask&FieldMaxHealth != 0 {
+		data = appendUint32(data, s.MaxHealth)
+	}
+	if mask&FieldPoison != 0 {
+		data = appendUint32(data, s.Poison)
+	}
+	if mask&FieldStatus != 0 {
+		data = append(data, s.Status)
+	}
+	return data
+}
+
+func appendUint16(data []byte, v uint16) []byte {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return append(data, b[:]...)
+}
+
+func appendUint32(data []byte, v uint32) []byte {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return append(data, b[:]...)
+}
+
+func appendFloat64(data []byte, v float64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], math.Float64bits(v))
+	return append(data, b[:]...)
+}
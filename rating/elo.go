@@ -0,0 +1,54 @@
+package rating
+
+import (
+	"math"
+)
+
+// 한 판에 움직일 수 있는 최대 점수
+var K = 32.0
+
+// Expected is the chance a player rated a beats one rated b.
+func Expected(a, b int) float64 {
+	return 1 / (1 + math.Pow(10, float64(b-a)/400))
+}
+
+// Update returns the ratings after a match in which winner beat every other player.
+// The losers count as a draw among themselves, so a loser to a much weaker
+// winner still loses less than that winner gains.
+func Update(ratings []int, winner int) []int {
+	places := make([]int, len(ratings))
+	for i := range places {
+		if i != winner {
+			places[i] = 1
+		}
+	}
+	return Rank(ratings, places)
+}
+
+// Rank returns the ratings after a match that finished in places, 0 being
+// first. Players on the same place count as a draw between them.
+func Rank(ratings []int, places []int) []int {
+	n := len(ratings)
+	out := make([]int, n)
+	if n < 2 {
+		copy(out, ratings)
+		return out
+	}
+	for i := range ratings {
+		var delta float64
+		for j := range ratings {
+			if i == j {
+				continue
+			}
+			score := 0.5
+			if places[i] < places[j] {
+				score = 1
+			} else if places[i] > places[j] {
+				score = 0
+			}
+			delta += score - Expected(ratings[i], ratings[j])
+		}
+		out[i] = ratings[i] + int(math.Round(K*delta/float64(n-1)))
+	}
+	return out
+}
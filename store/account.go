@@ -0,0 +1,58 @@
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"app/ent/player"
+	"app/protocol"
+)
+
+var ErrWrongSecret = protocol.Error{Code: protocol.ErrCodeWrongPassword, Message: "that name belongs to someone else"}
+
+const saltSize = 16
+
+func hashSecret(salt []byte, secret string) []byte {
+	sum := sha256.Sum256(append(append([]byte(nil), salt...), secret...))
+	return append(append([]byte(nil), salt...), sum[:]...)
+}
+
+// Login claims name for whoever first logs in with it and checks secret against
+// that claim from then on. Names that only have ratings from before are claimable.
+func (s *Store) Login(ctx context.Context, name, secret string) error {
+	p, err := s.Player(ctx, name)
+	if err != nil {
+		return err
+	}
+	if p.Secret == "" {
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		n, err := s.client.Player.Update().
+			Where(player.ID(p.ID), player.SecretIsNil()).
+			SetSecret(hex.EncodeToString(hashSecret(salt, secret))).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 1 {
+			return nil
+		}
+		// 동시에 다른 사람이 가져갔다
+		if p, err = s.client.Player.Get(ctx, p.ID); err != nil {
+			return err
+		}
+	}
+	stored, err := hex.DecodeString(p.Secret)
+	if err != nil || len(stored) < saltSize {
+		return ErrWrongSecret
+	}
+	if subtle.ConstantTimeCompare(stored, hashSecret(stored[:saltSize], secret)) != 1 {
+		return ErrWrongSecret
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	"log"
+
+	"app/ent"
+	"app/ent/ownedcard"
+	"app/ent/player"
+	"app/object/games"
+	"app/protocol"
+)
+
+var ErrUnknownCard = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "unknown card"}
+
+// grantCard adds id to p's collection; owning it already is not an error.
+func grantCard(ctx context.Context, client *ent.Client, p *ent.Player, id uint32, source ownedcard.Source) (bool, error) {
+	_, err := client.OwnedCard.Create().
+		SetOwner(p).
+		SetCard(int(id)).
+		SetSource(source).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// collect returns name's record after handing out the starter cards, and the
+// cards their experience already unlocks, the first time it is looked at.
+// It happens once, so a revoked starter card stays revoked.
+func (s *Store) collect(ctx context.Context, name string) (*ent.Player, error) {
+	p, err := s.Player(ctx, name)
+	if err != nil || p.StarterGranted {
+		return p, err
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := tx.Player.Update().
+		Where(player.ID(p.ID), player.StarterGranted(false)).
+		SetStarterGranted(true).
+		Save(ctx)
+	if err != nil || n == 0 { // 다른 곳에서 먼저 줬다
+		tx.Rollback()
+		return p, err
+	}
+	c := games.CurrentCatalog()
+	for _, id := range c.Starters() {
+		if _, err := grantCard(ctx, tx.Client(), p, id, ownedcard.SourceStarter); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	for _, id := range c.Unlocks(0, p.Experience) {
+		if _, err := grantCard(ctx, tx.Client(), p, id, ownedcard.SourceUnlock); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+	return p, tx.Commit()
+}
+
+func (s *Store) Collection(ctx context.Context, name string) (protocol.Collection, error) {
+	p, err := s.collect(ctx, name)
+	if err != nil {
+		return protocol.Collection{}, err
+	}
+	cards, err := p.QueryCards().Order(ent.Asc(ownedcard.FieldCard)).All(ctx)
+	if err != nil {
+		return protocol.Collection{}, err
+	}
+	col := protocol.Collection{Experience: uint32(p.Experience)}
+	for _, c := range cards {
+		col.Cards = append(col.Cards, uint32(c.Card))
+	}
+	return col, nil
+}
+
+// GrantCard gives name card id, reporting false if they already had it.
+func (s *Store) GrantCard(ctx context.Context, name string, id uint32) (bool, error) {
+	if !games.CurrentCatalog().Registry().Has(id) {
+		return false, ErrUnknownCard
+	}
+	p, err := s.collect(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return grantCard(ctx, s.client, p, id, ownedcard.SourceAdmin)
+}
+
+// RevokeCard takes card id away from name, reporting false if they did not have it.
+// Saved decks holding it stay, but can no longer be selected.
+func (s *Store) RevokeCard(ctx context.Context, name string, id uint32) (bool, error) {
+	p, err := s.collect(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	n, err := s.client.OwnedCard.Delete().
+		Where(ownedcard.Card(int(id)), ownedcard.HasOwnerWith(player.ID(p.ID))).
+		Exec(ctx)
+	return n > 0, err
+}
+
+// AwardExperience gives every named player who stayed to the end of a match
+// experience and the cards it unlocks, by the catalog the match was played with.
+func (s *Store) AwardExperience(ctx context.Context, res games.Result) error {
+	c := res.Catalog
+	for _, seat := range res.Players {
+		if seat.Name == "" || seat.Left {
+			continue
+		}
+		xp := c.Progression.MatchXP
+		if seat.Team == res.Winner {
+			xp += c.Progression.WinXP
+		}
+		p, err := s.collect(ctx, seat.Name)
+		if err != nil {
+			return err
+		}
+		p, err = p.Update().AddExperience(xp).Save(ctx)
+		if err != nil {
+			return err
+		}
+		for _, id := range c.Unlocks(p.Experience-xp, p.Experience) {
+			ok, err := grantCard(ctx, s.client, p, id, ownedcard.SourceUnlock)
+			if err != nil {
+				return err
+			}
+			if ok {
+				log.Println(seat.Name, "unlocked card", id)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+
+	"app/ent"
+	"app/ent/deck"
+	"app/ent/player"
+	"app/protocol"
+)
+
+var (
+	ErrDeckNotFound = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "deck not found"}
+	ErrTooManyDecks = protocol.Error{Code: protocol.ErrCodeNotAllowed, Message: "saved deck limit reached"}
+)
+
+func savedDeck(d *ent.Deck) protocol.SavedDeck {
+	sd := protocol.SavedDeck{ID: uint32(d.ID), Name: d.Name}
+	copy(sd.Cards[:], d.Cards)
+	return sd
+}
+
+// SaveDeck creates a deck for owner when d.ID is 0, otherwise overwrites that deck of theirs.
+func (s *Store) SaveDeck(ctx context.Context, owner string, d protocol.SavedDeck) (protocol.SavedDeck, error) {
+	p, err := s.Player(ctx, owner)
+	if err != nil {
+		return protocol.SavedDeck{}, err
+	}
+	if d.ID == 0 {
+		n, err := p.QueryDecks().Count(ctx)
+		if err != nil {
+			return protocol.SavedDeck{}, err
+		}
+		if n >= protocol.MaxDecks {
+			return protocol.SavedDeck{}, ErrTooManyDecks
+		}
+		saved, err := s.client.Deck.Create().
+			SetOwner(p).
+			SetName(d.Name).
+			SetCards(d.Cards[:]).
+			Save(ctx)
+		if err != nil {
+			return protocol.SavedDeck{}, err
+		}
+		return savedDeck(saved), nil
+	}
+
+	n, err := s.client.Deck.Update().
+		Where(deck.ID(int(d.ID)), deck.HasOwnerWith(player.ID(p.ID))).
+		SetName(d.Name).
+		SetCards(d.Cards[:]).
+		Save(ctx)
+	if err != nil {
+		return protocol.SavedDeck{}, err
+	}
+	if n == 0 {
+		return protocol.SavedDeck{}, ErrDeckNotFound
+	}
+	return d, nil
+}
+
+// Decks lists owner's saved decks, oldest first.
+func (s *Store) Decks(ctx context.Context, owner string) ([]protocol.SavedDeck, error) {
+	p, err := s.Player(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	decks, err := p.QueryDecks().Order(ent.Asc(deck.FieldID)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]protocol.SavedDeck, len(decks))
+	for i, d := range decks {
+		list[i] = savedDeck(d)
+	}
+	return list, nil
+}
+
+func (s *Store) Deck(ctx context.Context, owner string, id uint32) (protocol.SavedDeck, error) {
+	d, err := s.client.Deck.Query().
+		Where(deck.ID(int(id)), deck.HasOwnerWith(player.Name(owner))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return protocol.SavedDeck{}, ErrDeckNotFound
+	}
+	if err != nil {
+		return protocol.SavedDeck{}, err
+	}
+	return savedDeck(d), nil
+}
@@ -0,0 +1,262 @@
+package store
+
+import (
+	"context"
+
+	"app/ent"
+	"app/ent/player"
+	"app/ent/ratingchange"
+	"app/object/games"
+	"app/protocol"
+	"app/rating"
+)
+
+// 처음 보는 플레이어의 레이팅
+const DefaultRating = 1000
+
+var ErrPlayerNotFound = protocol.Error{Code: protocol.ErrCodeNotFound, Message: "player not found"}
+
+// Store keeps player records in the ent database.
+type Store struct {
+	client *ent.Client
+}
+
+func New(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Player returns the record for name, creating it on first sight.
+func (s *Store) Player(ctx context.Context, name string) (*ent.Player, error) {
+	p, err := s.client.Player.Query().Where(player.Name(name)).Only(ctx)
+	if ent.IsNotFound(err) {
+		p, err = s.client.Player.Create().
+			SetType(1).
+			SetName(name).
+			SetRating(DefaultRating).
+			Save(ctx)
+		if ent.IsConstraintError(err) { // 동시에 만들어졌다
+			return s.client.Player.Query().Where(player.Name(name)).Only(ctx)
+		}
+	}
+	return p, err
+}
+
+// Rating is name's rating for role, or the overall one when role is NoTeam.
+func (s *Store) Rating(ctx context.Context, name string, role byte) (int, error) {
+	p, err := s.Player(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if role == protocol.NoTeam {
+		return p.Rating, nil
+	}
+	return roleRating(p, role), nil
+}
+
+func (s *Store) SetRating(ctx context.Context, name string, rating int) error {
+	return s.client.Player.Update().
+		Where(player.Name(name)).
+		SetRating(rating).
+		Exec(ctx)
+}
+
+// 팀 번호가 곧 역할이다 : 0 지구(수비), 1 공격, 2 세모(독)
+func roleRating(p *ent.Player, role byte) int {
+	switch role {
+	case 0:
+		return p.DefenderRating
+	case 1:
+		return p.AttackerRating
+	}
+	return p.SemoRating
+}
+
+func setRoleRating(u *ent.PlayerUpdateOne, role byte, v int) *ent.PlayerUpdateOne {
+	switch role {
+	case 0:
+		return u.SetDefenderRating(v)
+	case 1:
+		return u.SetAttackerRating(v)
+	}
+	return u.SetSemoRating(v)
+}
+
+// RecordResult updates the overall and role ratings of everyone in a finished match
+// and keeps a history row per player. Players who left before the end lose to
+// everyone who stayed; matches with an unnamed or repeated player are not rated.
+func (s *Store) RecordResult(ctx context.Context, res games.Result) error {
+	var names [protocol.TeamCount]string
+	var places [protocol.TeamCount]int
+	seen := map[string]bool{}
+	for _, seat := range res.Players {
+		if seat.Team >= protocol.TeamCount || seat.Name == "" || seen[seat.Name] {
+			return nil
+		}
+		seen[seat.Name] = true
+		names[seat.Team] = seat.Name
+		switch {
+		case seat.Left:
+			places[seat.Team] = 2
+		case seat.Team == res.Winner:
+			places[seat.Team] = 0
+		default:
+			places[seat.Team] = 1
+		}
+	}
+	for _, name := range names {
+		if name == "" {
+			return nil
+		}
+	}
+
+	// 없는 사람은 먼저 만들어 두고, 레이팅은 트랜잭션 안에서 읽는다
+	var ids [protocol.TeamCount]int
+	for team, name := range names {
+		p, err := s.Player(ctx, name)
+		if err != nil {
+			return err
+		}
+		ids[team] = p.ID
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	var players [protocol.TeamCount]*ent.Player
+	var overall, role []int
+	for team, id := range ids {
+		p, err := tx.Player.Get(ctx, id)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		players[team] = p
+		overall = append(overall, p.Rating)
+		role = append(role, roleRating(p, byte(team)))
+	}
+	overall = rating.Rank(overall, places[:])
+	role = rating.Rank(role, places[:])
+
+	for team, p := range players {
+		u := tx.Player.UpdateOne(p).SetRating(overall[team])
+		if _, err := setRoleRating(u, byte(team), role[team]).Save(ctx); err != nil {
+			tx.Rollback()
+			return err
+		}
+		_, err := tx.RatingChange.Create().
+			SetPlayer(p).
+			SetRole(team).
+			SetBefore(roleRating(p, byte(team))).
+			SetAfter(role[team]).
+			SetWon(places[team] == 0).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Ratings returns name's ratings with up to limit of the latest changes.
+// Asking about a name nobody has played under does not create a record for it.
+func (s *Store) Ratings(ctx context.Context, name string, limit int) (protocol.Ratings, error) {
+	p, err := s.client.Player.Query().Where(player.Name(name)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return protocol.Ratings{}, ErrPlayerNotFound
+	}
+	if err != nil {
+		return protocol.Ratings{}, err
+	}
+	history, err := p.QueryRatings().
+		Order(ent.Desc(ratingchange.FieldCreatedAt), ent.Desc(ratingchange.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return protocol.Ratings{}, err
+	}
+
+	r := protocol.Ratings{
+		Name:    p.Name,
+		Overall: uint16(p.Rating),
+	}
+	for role := range r.Roles {
+		r.Roles[role] = uint16(roleRating(p, byte(role)))
+	}
+	for _, h := range history {
+		r.History = append(r.History, protocol.RatingEntry{
+			Role:   byte(h.Role),
+			Before: uint16(h.Before),
+			After:  uint16(h.After),
+			Won:    h.Won,
+			Time:   uint32(h.CreatedAt.Unix()),
+		})
+	}
+	return r, nil
+}
+
+// SaveMatch writes a finished match and its participants, including those who
+// left before the end, returning the new game id.
+func (s *Store) SaveMatch(ctx context.Context, res games.Result) (int, error) {
+	players := map[string]*ent.Player{}
+	for _, seat := range res.Players {
+		if seat.Name == "" {
+			continue
+		}
+		p, err := s.Player(ctx, seat.Name)
+		if err != nil {
+			return 0, err
+		}
+		players[seat.Name] = p
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	create := tx.Game.Create().
+		SetWinner(int(res.Winner)).
+		SetDuration(int(res.Duration)).
+		SetEvents(res.Events.Strings()).
+		SetCatalogVersion(res.Catalog.Version)
+	if !res.Started.IsZero() {
+		create.SetCreatedAt(res.Started)
+	}
+	g, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	for _, seat := range res.Players {
+		c := tx.Participant.Create().
+			SetGame(g).
+			SetTeam(int(seat.Team)).
+			SetDeck(seat.Deck).
+			SetLeft(seat.Left)
+		if p, ok := players[seat.Name]; ok {
+			c.SetName(seat.Name).SetPlayer(p)
+		}
+		if _, err := c.Save(ctx); err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+	return g.ID, tx.Commit()
+}
+
+// Timeline returns the recorded events of a match, optionally only those of kinds.
+func (s *Store) Timeline(ctx context.Context, gameID int, kinds ...games.EventKind) (games.Timeline, error) {
+	g, err := s.client.Game.Get(ctx, gameID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := games.ParseTimeline(g.Events)
+	if err != nil {
+		return nil, err
+	}
+	if len(kinds) > 0 {
+		t = t.Kind(kinds...)
+	}
+	return t, nil
+}